@@ -0,0 +1,77 @@
+package collector
+
+import (
+	"fmt"
+	"strings"
+
+	"zerog-exporter/rpc"
+	"zerog-exporter/util"
+)
+
+// ValidateConfiguredAddresses checks the configured wallet addresses against the chain's account prefix
+// and the configured validators for consensus addresses (hex, base64 or bech32), returning one error per
+// offending address. Wallets are not checked when account_prefix is unset.
+func (c *UnifiedCollector) ValidateConfiguredAddresses() []error {
+	var errs []error
+
+	if c.cfg.AccountPrefix != "" {
+		for _, wallet := range c.cfg.Wallets {
+			if err := util.ValidateBech32Address(wallet.Address, c.cfg.AccountPrefix); err != nil {
+				errs = append(errs, fmt.Errorf("wallet %s (%s): %w", wallet.Address, wallet.Name, err))
+			}
+		}
+	}
+
+	for _, validatorAddr := range c.cfg.Validators {
+		if _, err := util.NormalizeConsensusAddress(validatorAddr); err != nil {
+			errs = append(errs, fmt.Errorf("validator %s: %w", validatorAddr, err))
+		}
+	}
+
+	return errs
+}
+
+// consensusHexMap maps each configured validator address to its canonical uppercase hex form
+// (addresses that can't be decoded are only uppercased)
+func consensusHexMap(validators []string) map[string]string {
+	hexAddrs := make(map[string]string, len(validators))
+	for _, validatorAddr := range validators {
+		hexAddr, err := util.NormalizeConsensusAddress(validatorAddr)
+		if err != nil {
+			hexAddr = strings.ToUpper(validatorAddr)
+		}
+		hexAddrs[validatorAddr] = hexAddr
+	}
+	return hexAddrs
+}
+
+// consensusHex returns the canonical hex form of a configured validator address, used to match it against
+// block signatures, signing infos and the validator set
+func (c *UnifiedCollector) consensusHex(validatorAddr string) string {
+	if hexAddr, ok := c.validatorHex[validatorAddr]; ok {
+		return hexAddr
+	}
+	return strings.ToUpper(validatorAddr)
+}
+
+// hasLastCommit reports whether the block carries last commit signatures. The genesis block and some heights have
+// none, so they say nothing about who signed and are skipped instead of being counted as missed
+func hasLastCommit(block *rpc.BlockResponse) bool {
+	return len(block.Result.Block.LastCommit.Signatures) > 0
+}
+
+// signatureFlags maps the canonical hex address of each last commit signature to its block_id_flag
+func signatureFlags(block *rpc.BlockResponse) map[string]int {
+	flags := make(map[string]int, len(block.Result.Block.LastCommit.Signatures))
+	for _, sig := range block.Result.Block.LastCommit.Signatures {
+		if sig.ValidatorAddress == "" {
+			continue
+		}
+		hexAddr, err := util.NormalizeConsensusAddress(sig.ValidatorAddress)
+		if err != nil {
+			hexAddr = strings.ToUpper(sig.ValidatorAddress)
+		}
+		flags[hexAddr] = sig.BlockIDFlag
+	}
+	return flags
+}
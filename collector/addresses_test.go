@@ -0,0 +1,96 @@
+package collector
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"zerog-exporter/rpc"
+)
+
+const (
+	knownConsensusHex    = "5E8A8F4C6B8D0E2F4A6C8E0B2D4F6A8C0E2B4D6F"
+	knownConsensusBase64 = "XoqPTGuNDi9KbI4LLU9qjA4rTW8="
+)
+
+func TestSignatureFlagsNormalizesEncodings(t *testing.T) {
+	// 같은 commit에 hex (소문자 포함) / base64 서명 주소가 섞인 블록
+	var block rpc.BlockResponse
+	payload := `{"jsonrpc":"2.0","id":-1,"result":{"block":{"header":{"chain_id":"0g-fake-1","height":"1200000","time":"2026-10-16T12:00:00Z","proposer_address":"5E8A8F4C6B8D0E2F4A6C8E0B2D4F6A8C0E2B4D6F"},"last_commit":{"height":"1199999","round":0,"signatures":[
+  {"block_id_flag":4,"validator_address":"` + knownConsensusBase64 + `","timestamp":"2026-10-16T11:59:59.5Z","signature":"c2lnbmF0dXJl"},
+  {"block_id_flag":4,"validator_address":"7e5f4552091a69125d5dfcb7b8c2659029395bdf","timestamp":"2026-10-16T11:59:59.5Z","signature":"c2lnbmF0dXJl"},
+  {"block_id_flag":5,"validator_address":"4B20993BC481177EC7E8F571CECAE8A9E22C02DB","timestamp":"0001-01-01T00:00:00Z","signature":null},
+  {"block_id_flag":5,"validator_address":"","timestamp":"0001-01-01T00:00:00Z","signature":null}
+]}}}}`
+	if err := json.Unmarshal([]byte(payload), &block); err != nil {
+		t.Fatal(err)
+	}
+
+	flags := signatureFlags(&block)
+	want := map[string]int{
+		knownConsensusHex:                          4,
+		"7E5F4552091A69125D5DFCB7B8C2659029395BDF": 4,
+		"4B20993BC481177EC7E8F571CECAE8A9E22C02DB": 5,
+	}
+	if len(flags) != len(want) {
+		t.Errorf("signatureFlags = %v, want %v", flags, want)
+	}
+	for address, flag := range want {
+		if flags[address] != flag {
+			t.Errorf("flag of %s = %d, want %d", address, flags[address], flag)
+		}
+	}
+}
+
+func TestConsensusHexMapConfigEncodings(t *testing.T) {
+	bech32Addr := valconsAddress(t, knownConsensusHex)
+	configured := []string{
+		knownConsensusHex,
+		strings.ToLower(knownConsensusHex),
+		"0x" + strings.ToLower(knownConsensusHex),
+		knownConsensusBase64,
+		bech32Addr,
+	}
+	for address, hexAddr := range consensusHexMap(configured) {
+		if hexAddr != knownConsensusHex {
+			t.Errorf("consensusHexMap[%s] = %s, want %s", address, hexAddr, knownConsensusHex)
+		}
+	}
+}
+
+func TestCollectMatchesSignaturesAcrossEncodings(t *testing.T) {
+	validators := fakeValidators(4)
+	validators[1].signBase64 = true
+	validators[3].missing = true
+
+	raw, err := hex.DecodeString(validators[2].consensusHex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 설정은 bech32 / 0x 소문자 hex / base64, 블록 서명은 hex 또는 base64
+	configured := []string{
+		validators[0].consensusBech32(t),
+		"0x" + strings.ToLower(validators[1].consensusHex()),
+		base64.StdEncoding.EncodeToString(raw),
+		validators[3].consensusBech32(t),
+	}
+	c := newFakeCollector(t, validators, configured...)
+	samples := collectSamples(t, c)
+
+	// ScanWindow 10: 첫 수집에서 스캔한 10블록이 모두 집계됨
+	for i, address := range configured {
+		wantSigned, wantMissed := 10.0, 0.0
+		if validators[i].missing {
+			wantSigned, wantMissed = 0, 10
+		}
+		labels := map[string]string{"address": address}
+		if got := sampleValue(t, samples, "cosmos_validator_signed_blocks_total", labels); got != wantSigned {
+			t.Errorf("%s signed blocks = %v, want %v", address, got, wantSigned)
+		}
+		if got := sampleValue(t, samples, "cosmos_validator_missed_blocks_total", labels); got != wantMissed {
+			t.Errorf("%s missed blocks = %v, want %v", address, got, wantMissed)
+		}
+	}
+}
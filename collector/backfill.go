@@ -0,0 +1,120 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"zerog-exporter/rpc"
+	"zerog-exporter/util"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentBackfillBlocks bounds the number of blocks fetched at the same time during a backfill
+const maxConcurrentBackfillBlocks = 8
+
+// Backfill processes the signatures of every block in [from, to] into the cumulative signed / missed / proposed
+// block counters, e.g. to reconstruct miss history after the exporter was down. The range is clamped to the
+// blocks the node retains and to its latest height; pruned blocks are skipped. It must run before background
+// collection starts, since the block scan then only counts blocks above the backfilled range.
+// It returns the number of blocks processed.
+func (c *UnifiedCollector) Backfill(ctx context.Context, from, to int64) (int64, error) {
+	client := c.client.WithContext(ctx)
+	status, err := client.GetStatus()
+	if err != nil {
+		return 0, err
+	}
+	latestHeight, err := util.ParseBlockHeight(status.Result.SyncInfo.LatestBlockHeight)
+	if err != nil {
+		return 0, err
+	}
+	if earliest, err := util.ParseBlockHeight(status.Result.SyncInfo.EarliestBlockHeight); err == nil && earliest > from {
+		c.logger.Warn("Backfill range starts before the earliest retained block", "chain_id", c.cfg.ChainID, "from", from, "earliest_height", earliest)
+		from = earliest
+	}
+	if to > latestHeight {
+		to = latestHeight
+	}
+	if from > to {
+		return 0, fmt.Errorf("no retained blocks in backfill range %d:%d (latest height %d)", from, to, latestHeight)
+	}
+
+	var (
+		mu        sync.Mutex
+		signed    = make(map[string]int)
+		missed    = make(map[string]int)
+		proposed  = make(map[string]int)
+		processed int64
+	)
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentBackfillBlocks)
+	for height := from; height <= to; height++ {
+		height := height
+		g.Go(func() error {
+			if gctx.Err() != nil {
+				return gctx.Err()
+			}
+			block, err := client.GetBlock(int(height))
+			var heightErr *rpc.HeightNotAvailableError
+			if errors.As(err, &heightErr) {
+				if heightErr.Pruned {
+					c.recordPrunedHeight(heightErr)
+				}
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("block %d: %w", height, err)
+			}
+
+			flags := signatureFlags(block)
+			commit := hasLastCommit(block)
+			proposerHex, err := util.NormalizeConsensusAddress(block.Result.Block.Header.ProposerAddress)
+			if err != nil {
+				proposerHex = ""
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, validatorAddr := range c.cfg.Validators {
+				hexAddr := c.consensusHex(validatorAddr)
+				// block_id_flag: 4 = Commit (서명됨), 빈 last commit은 서명 집계에서 제외
+				if commit {
+					if flags[hexAddr] == 4 {
+						signed[validatorAddr]++
+					} else {
+						missed[validatorAddr]++
+					}
+				}
+				if hexAddr == proposerHex {
+					proposed[validatorAddr]++
+				}
+			}
+			processed++
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return 0, err
+	}
+
+	c.mu.Lock()
+	for validatorAddr, count := range signed {
+		c.signedBlocksTotal[validatorAddr] += float64(count)
+	}
+	for validatorAddr, count := range missed {
+		c.missedBlocksTotal[validatorAddr] += float64(count)
+	}
+	for validatorAddr, count := range proposed {
+		c.proposedBlocksTotal[validatorAddr] += float64(count)
+	}
+	// 이후 스캔은 backfill 범위 이후의 블록만 카운트 (중복 방지)
+	if to > c.lastCountedHeight {
+		c.lastCountedHeight = to
+	}
+	c.mu.Unlock()
+
+	return processed, nil
+}
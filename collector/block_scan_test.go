@@ -0,0 +1,165 @@
+package collector
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+)
+
+// failingBlockCollector returns a collector for a fakeNode whose /block answers 503 for failHeight while failing is set
+func failingBlockCollector(t *testing.T, validators []fakeValidator, failHeight int64, failing *atomic.Bool, configured ...string) *UnifiedCollector {
+	t.Helper()
+	node := fakeNode(t, 1_200_000, validators)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/block" && failing.Load() && r.URL.Query().Get("height") == strconv.FormatInt(failHeight, 10) {
+			http.Error(w, "upstream timeout", http.StatusServiceUnavailable)
+			return
+		}
+		node.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := &config.Chain{ChainID: "0g-fake-1", Name: "fake", Validators: configured}
+	client := rpc.NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+	return NewUnifiedCollector(client, cfg, nil, &config.BlockTracking{ScanWindow: 10}, "")
+}
+
+func TestCountersCatchUpAfterFailedBlock(t *testing.T) {
+	validators := fakeValidators(2)
+	validators[1].missing = true
+	var failing atomic.Bool
+	failing.Store(true)
+	// 스캔 윈도우 1_199_991..1_200_000 중 1_199_995 조회 실패
+	c := failingBlockCollector(t, validators, 1_199_995, &failing, validators[0].consensusHex(), validators[1].consensusHex())
+
+	signer := map[string]string{"address": validators[0].consensusHex()}
+	misser := map[string]string{"address": validators[1].consensusHex()}
+
+	// 실패한 블록 아래 (1_199_991..1_199_994)만 카운트
+	samples := collectSamples(t, c)
+	if got := sampleValue(t, samples, "cosmos_validator_signed_blocks_total", signer); got != 4 {
+		t.Errorf("signed blocks after the failed fetch = %v, want 4", got)
+	}
+	if got := sampleValue(t, samples, "cosmos_validator_missed_blocks_total", misser); got != 4 {
+		t.Errorf("missed blocks after the failed fetch = %v, want 4", got)
+	}
+	c.mu.Lock()
+	counted := c.lastCountedHeight
+	c.mu.Unlock()
+	if counted != 1_199_994 {
+		t.Errorf("lastCountedHeight = %d, want 1199994", counted)
+	}
+
+	// 다음 수집에서 나머지 6블록을 따라잡음 (중복 없이)
+	failing.Store(false)
+	samples = collectSamples(t, c)
+	if got := sampleValue(t, samples, "cosmos_validator_signed_blocks_total", signer); got != 10 {
+		t.Errorf("signed blocks after catching up = %v, want 10", got)
+	}
+	if got := sampleValue(t, samples, "cosmos_validator_missed_blocks_total", misser); got != 10 {
+		t.Errorf("missed blocks after catching up = %v, want 10", got)
+	}
+
+	// 새 블록이 없으면 그대로
+	samples = collectSamples(t, c)
+	if got := sampleValue(t, samples, "cosmos_validator_signed_blocks_total", signer); got != 10 {
+		t.Errorf("signed blocks without new blocks = %v, want 10", got)
+	}
+}
+
+func TestBlockTimeHistogramCatchesUpAfterFailedBlock(t *testing.T) {
+	var failing atomic.Bool
+	failing.Store(true)
+	c := failingBlockCollector(t, fakeValidators(2), 1_199_995, &failing)
+
+	// 블록 시간은 이전 블록이 있어야 관측됨: 1_199_992..1_199_994 = 3개
+	collectSamples(t, c)
+	if got := histogramCount(t, c); got != 3 {
+		t.Errorf("block time observations after the failed fetch = %d, want 3", got)
+	}
+
+	failing.Store(false)
+	collectSamples(t, c)
+	if got := histogramCount(t, c); got != 9 {
+		t.Errorf("block time observations after catching up = %d, want 9", got)
+	}
+}
+
+// histogramCount returns the number of observations in cosmos_block_time_seconds
+func histogramCount(t *testing.T, c *UnifiedCollector) uint64 {
+	t.Helper()
+	var out dto.Metric
+	if err := c.blockTimeHistogram.Write(&out); err != nil {
+		t.Fatal(err)
+	}
+	return out.GetHistogram().GetSampleCount()
+}
+
+func TestFetchScanWindowBoundedConcurrency(t *testing.T) {
+	node := fakeNode(t, 1_200_000, fakeValidators(2))
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		node.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+	client := rpc.NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+
+	blocks := fetchScanWindow(client, 1_200_000, 40)
+	if len(blocks) != 40 {
+		t.Fatalf("blocks = %d, want 40", len(blocks))
+	}
+	// 최신 블록부터 순서대로
+	for i, result := range blocks {
+		if result.err != nil {
+			t.Fatalf("block %d: %v", i, result.err)
+		}
+		if want := strconv.Itoa(1_200_000 - i); result.block.Result.Block.Header.Height != want {
+			t.Errorf("blocks[%d] height = %s, want %s", i, result.block.Result.Block.Header.Height, want)
+		}
+	}
+	if got := maxInFlight.Load(); got < 2 || got > maxConcurrentScanBlocks {
+		t.Errorf("max concurrent block requests = %d, want 2..%d", got, maxConcurrentScanBlocks)
+	}
+}
+
+func TestFetchScanWindowClampedToChainHeight(t *testing.T) {
+	node := fakeNode(t, 5, fakeValidators(2))
+	client := rpc.NewClientWithHTTPClient(node.URL, node.URL, "", node.Client())
+	if blocks := fetchScanWindow(client, 5, 100); len(blocks) != 5 {
+		t.Errorf("blocks = %d, want 5 (heights 5..1)", len(blocks))
+	}
+}
+
+func TestScanWindowActualExcludesFailedBlocks(t *testing.T) {
+	var failing atomic.Bool
+	failing.Store(true)
+	c := failingBlockCollector(t, fakeValidators(2), 1_199_995, &failing)
+
+	samples := collectSamples(t, c)
+	if got := sampleValue(t, samples, "cosmos_block_scan_window_actual", nil); got != 9 {
+		t.Errorf("cosmos_block_scan_window_actual with a failed fetch = %v, want 9", got)
+	}
+
+	failing.Store(false)
+	samples = collectSamples(t, c)
+	if got := sampleValue(t, samples, "cosmos_block_scan_window_actual", nil); got != 10 {
+		t.Errorf("cosmos_block_scan_window_actual = %v, want 10", got)
+	}
+}
@@ -0,0 +1,48 @@
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+)
+
+func TestAvgBlockTimeGaugeToggle(t *testing.T) {
+	disabled := false
+	tests := []struct {
+		name  string
+		gauge *bool
+		want  bool
+	}{
+		{name: "unset", gauge: nil, want: true},
+		{name: "disabled", gauge: &disabled, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := fakeNode(t, 1_200_000, fakeValidators(2))
+			cfg := &config.Chain{ChainID: "0g-fake-1", Name: "fake"}
+			client := rpc.NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+			c := NewUnifiedCollector(client, cfg, nil, &config.BlockTracking{ScanWindow: 10, AvgBlockTimeGauge: tt.gauge}, "")
+
+			// fakeNode 최신 블록 (11:59:59) 이전 블록 시간 - 블록당 1초
+			base := time.Date(2026, 10, 16, 11, 59, 57, 0, time.UTC)
+			c.blockTimeCalculator.UpdateBlockTime(1_199_998, base)
+			c.blockTimeCalculator.UpdateBlockTime(1_199_999, base.Add(time.Second))
+
+			samples := collectSamples(t, c)
+			if got := len(samples["cosmos_avg_block_time"]) > 0; got != tt.want {
+				t.Fatalf("cosmos_avg_block_time emitted = %v, want %v", got, tt.want)
+			}
+			if tt.want {
+				if got := sampleValue(t, samples, "cosmos_avg_block_time", nil); got != 1 {
+					t.Errorf("cosmos_avg_block_time = %v, want 1", got)
+				}
+			}
+			// EMA gauge는 설정과 무관하게 유지
+			if got := sampleValue(t, samples, "cosmos_block_time_ema", nil); got != 1 {
+				t.Errorf("cosmos_block_time_ema = %v, want 1", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package collector
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+)
+
+// blockTracker polls new blocks in the background and keeps a live consecutive-miss count per configured validator
+type blockTracker struct {
+	client               *rpc.Client
+	validators           []string
+	validatorHex         map[string]string // 설정된 주소 -> canonical hex
+	interval             time.Duration
+	maxConsecutiveMissed int
+	logger               *Logger
+
+	// websocket 구독 (wsURL이 비어 있으면 폴링만 사용)
+	wsURL        string
+	wsMaxBackoff time.Duration
+
+	mu                sync.RWMutex
+	lastHeight        int64
+	consecutiveMissed map[string]int
+	wsConnected       bool
+	wsReconnects      int
+}
+
+// newBlockTracker creates a blockTracker for the given validators
+func newBlockTracker(client *rpc.Client, validators []string, wsURL string, blockTracking *config.BlockTracking, logger *Logger) *blockTracker {
+	interval := time.Duration(blockTracking.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	if !blockTracking.UseWebSocket {
+		wsURL = ""
+	}
+	wsMaxBackoff := time.Duration(blockTracking.WebSocketMaxBackoff) * time.Second
+	if wsMaxBackoff <= 0 {
+		wsMaxBackoff = wsDefaultMaxBackoff
+	}
+
+	consecutiveMissed := make(map[string]int, len(validators))
+	for _, validatorAddr := range validators {
+		consecutiveMissed[validatorAddr] = 0
+	}
+
+	return &blockTracker{
+		client:               client,
+		validators:           validators,
+		validatorHex:         consensusHexMap(validators),
+		interval:             interval,
+		maxConsecutiveMissed: blockTracking.MaxConsecutiveMissed,
+		logger:               logger,
+		wsURL:                wsURL,
+		wsMaxBackoff:         wsMaxBackoff,
+		consecutiveMissed:    consecutiveMissed,
+	}
+}
+
+// Run tracks new blocks until ctx is cancelled, over the websocket subscription if configured or by polling
+func (t *blockTracker) Run(ctx context.Context) {
+	if t.wsURL != "" {
+		t.runWebSocket(ctx)
+		return
+	}
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		t.poll()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll processes every block produced since the last poll
+func (t *blockTracker) poll() {
+	status, err := t.client.GetStatus()
+	if err != nil {
+		t.logger.Error("Block tracker failed to get node status", "error", err)
+		return
+	}
+
+	latestHeight, err := strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
+	if err != nil {
+		t.logger.Error("Block tracker failed to parse latest height", "error", err)
+		return
+	}
+
+	t.mu.RLock()
+	fromHeight := t.lastHeight + 1
+	t.mu.RUnlock()
+
+	// 첫 poll 이거나 너무 뒤처진 경우 최신 블록부터 시작
+	if fromHeight <= 1 || latestHeight-fromHeight > 100 {
+		fromHeight = latestHeight
+	}
+
+	for height := fromHeight; height <= latestHeight; height++ {
+		block, err := t.client.GetBlock(int(height))
+		if err != nil {
+			t.logger.Error("Block tracker failed to get block", "height", height, "error", err)
+			return
+		}
+		t.processBlock(height, block)
+	}
+}
+
+// processBlock updates the consecutive-miss counts from a block's last commit (unchanged when the commit is empty)
+func (t *blockTracker) processBlock(height int64, block *rpc.BlockResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lastHeight = height
+	if !hasLastCommit(block) {
+		return
+	}
+
+	flags := signatureFlags(block)
+	for _, validatorAddr := range t.validators {
+		// block_id_flag: 4 = Commit (서명됨), 5 = Absent (서명 안됨)
+		hasSigned := flags[t.validatorHex[validatorAddr]] == 4
+
+		if hasSigned {
+			t.consecutiveMissed[validatorAddr] = 0
+		} else {
+			t.consecutiveMissed[validatorAddr]++
+		}
+	}
+}
+
+// ConsecutiveMissed returns a copy of the current consecutive-miss count per validator
+func (t *blockTracker) ConsecutiveMissed() map[string]int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	snapshot := make(map[string]int, len(t.consecutiveMissed))
+	for addr, missed := range t.consecutiveMissed {
+		snapshot[addr] = missed
+	}
+	return snapshot
+}
+
+// MissAlert reports whether the consecutive-miss count has reached max_consecutive_missed
+func (t *blockTracker) MissAlert(consecutiveMissed int) bool {
+	return t.maxConsecutiveMissed > 0 && consecutiveMissed >= t.maxConsecutiveMissed
+}
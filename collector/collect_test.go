@@ -0,0 +1,288 @@
+package collector
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+	"zerog-exporter/util"
+)
+
+// fakeValidator is a validator served by fakeNode
+type fakeValidator struct {
+	moniker  string
+	operator string
+	pubKey   string // base64 ed25519
+	missing  bool   // never signs
+	status   string // staking status, "" = BOND_STATUS_BONDED
+	jailed   bool
+	// block signatures carry the consensus address as base64 instead of hex (some endpoints / versions)
+	signBase64 bool
+
+	// slashing signing info
+	tombstoned    bool
+	jailedUntil   string // "" = 1970-01-01T00:00:00Z (never jailed)
+	startHeight   int64
+	missedCounter int
+}
+
+func (v fakeValidator) consensusHex() string {
+	return util.GenerateConsensusAddressFromPubkey(v.pubKey)
+}
+
+// consensusBech32 returns the 0gvalcons address the slashing module reports for the validator
+func (v fakeValidator) consensusBech32(t *testing.T) string {
+	t.Helper()
+	return valconsAddress(t, v.consensusHex())
+}
+
+// valconsAddress encodes a hex consensus address as a 0gvalcons bech32 address
+func valconsAddress(t *testing.T, hexAddr string) string {
+	t.Helper()
+	raw, err := hex.DecodeString(hexAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := bech32.ConvertBits(raw, 8, 5, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	address, err := bech32.Encode("0gvalcons", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return address
+}
+
+// fakeNode serves the CometBFT RPC and Cosmos REST endpoints a collection queries with canned responses for a
+// chain at latestHeight; unknown paths answer 501 like a gateway without the module
+func fakeNode(t *testing.T, latestHeight int64, validators []fakeValidator) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"node_info":{"id":"5b1c7a6f0b3e2d9c8a7f6e5d4c3b2a1908f7e6d5","moniker":"fake-node","network":"0g-fake-1","version":"0.38.12"},"sync_info":{"latest_block_height":"%d","latest_block_time":"2026-10-16T11:59:59.000000000Z","earliest_block_height":"1","catching_up":false}}}`, latestHeight)
+	})
+	mux.HandleFunc("/block", func(w http.ResponseWriter, r *http.Request) {
+		height, err := strconv.ParseInt(r.URL.Query().Get("height"), 10, 64)
+		if err != nil {
+			height = latestHeight
+		}
+		if height > latestHeight {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"height %d must be less than or equal to the current blockchain height %d"}}`, height, latestHeight)
+			return
+		}
+		var signatures []string
+		for _, v := range validators {
+			if v.missing {
+				signatures = append(signatures, `{"block_id_flag":5,"validator_address":"","timestamp":"0001-01-01T00:00:00Z","signature":null}`)
+				continue
+			}
+			signer := v.consensusHex()
+			if v.signBase64 {
+				raw, _ := hex.DecodeString(signer)
+				signer = base64.StdEncoding.EncodeToString(raw)
+			}
+			signatures = append(signatures, fmt.Sprintf(`{"block_id_flag":4,"validator_address":"%s","timestamp":"2026-10-16T11:59:59.5Z","signature":"c2lnbmF0dXJl"}`, signer))
+		}
+		proposer := validators[int(height)%len(validators)].consensusHex()
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"block":{"header":{"chain_id":"0g-fake-1","height":"%d","time":"2026-10-16T11:59:%02d.000000000Z","proposer_address":"%s"},"last_commit":{"height":"%d","round":0,"signatures":[%s]}}}}`,
+			height, 60-int(latestHeight-height)%60-1, proposer, height-1, strings.Join(signatures, ","))
+	})
+	mux.HandleFunc("/validators", func(w http.ResponseWriter, r *http.Request) {
+		var entries []string
+		for _, v := range validators {
+			entries = append(entries, fmt.Sprintf(`{"address":"%s","pub_key":{"type":"tendermint/PubKeyEd25519","value":"%s"},"voting_power":"1000000","proposer_priority":"0"}`, v.consensusHex(), v.pubKey))
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"block_height":"%d","validators":[%s],"count":"%d","total":"%d"}}`, latestHeight, strings.Join(entries, ","), len(entries), len(entries))
+	})
+	mux.HandleFunc("/cosmos/staking/v1beta1/validators", func(w http.ResponseWriter, r *http.Request) {
+		var entries []string
+		for _, v := range validators {
+			status := v.status
+			if status == "" {
+				status = "BOND_STATUS_BONDED"
+			}
+			entries = append(entries, fmt.Sprintf(`{"operator_address":"%s","consensus_pubkey":{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"},"jailed":%t,"status":"%s","tokens":"1000000000000000000000000","delegator_shares":"1000000000000000000000000.000000000000000000","description":{"moniker":"%s"},"commission":{"commission_rates":{"rate":"0.050000000000000000","max_rate":"0.200000000000000000","max_change_rate":"0.010000000000000000"},"update_time":"2026-01-01T00:00:00Z"}}`, v.operator, v.pubKey, v.jailed, status, v.moniker))
+		}
+		fmt.Fprintf(w, `{"validators":[%s],"pagination":{"next_key":null,"total":"%d"}}`, strings.Join(entries, ","), len(entries))
+	})
+	mux.HandleFunc("/cosmos/slashing/v1beta1/signing_infos", func(w http.ResponseWriter, r *http.Request) {
+		var entries []string
+		for _, v := range validators {
+			jailedUntil := v.jailedUntil
+			if jailedUntil == "" {
+				jailedUntil = "1970-01-01T00:00:00Z"
+			}
+			entries = append(entries, fmt.Sprintf(`{"address":"%s","start_height":"%d","index_offset":"%d","jailed_until":"%s","tombstoned":%t,"missed_blocks_counter":"%d"}`,
+				v.consensusBech32(t), v.startHeight, latestHeight-v.startHeight, jailedUntil, v.tombstoned, v.missedCounter))
+		}
+		fmt.Fprintf(w, `{"info":[%s],"pagination":{"next_key":null,"total":"%d"}}`, strings.Join(entries, ","), len(entries))
+	})
+	mux.HandleFunc("/cosmos/slashing/v1beta1/params", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"params":{"signed_blocks_window":"10000","min_signed_per_window":"0.050000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.000100000000000000"}}`)
+	})
+	mux.HandleFunc("/cosmos/staking/v1beta1/params", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"params":{"unbonding_time":"1814400s","max_validators":125,"max_entries":7,"historical_entries":10000,"bond_denom":"ua0gi","min_commission_rate":"0.000000000000000000"}}`)
+	})
+	mux.HandleFunc("/cosmos/staking/v1beta1/pool", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"pool":{"not_bonded_tokens":"2500000000000000000000","bonded_tokens":"3000000000000000000000000"}}`)
+	})
+	mux.HandleFunc("/cosmos/distribution/v1beta1/params", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"params":{"community_tax":"0.020000000000000000","base_proposer_reward":"0.000000000000000000","bonus_proposer_reward":"0.000000000000000000","withdraw_addr_enabled":true}}`)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotImplemented)
+		fmt.Fprint(w, `{"code":12,"message":"Not Implemented","details":[]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// fakeValidators returns n validators with deterministic keys
+func fakeValidators(n int) []fakeValidator {
+	validators := make([]fakeValidator, n)
+	for i := range validators {
+		key := make([]byte, 32)
+		for j := range key {
+			key[j] = byte(i*31 + j + 1)
+		}
+		validators[i] = fakeValidator{
+			moniker:  fmt.Sprintf("validator-%d", i+1),
+			operator: fmt.Sprintf("0gvaloper1fake%02d", i+1),
+			pubKey:   base64.StdEncoding.EncodeToString(key),
+		}
+	}
+	return validators
+}
+
+func TestCollectConcurrent(t *testing.T) {
+	validators := fakeValidators(4)
+	validators[3].missing = true
+	server := fakeNode(t, 1_200_000, validators)
+
+	cfg := &config.Chain{
+		ChainID:    "0g-fake-1",
+		Name:       "fake",
+		Validators: []string{validators[0].consensusHex(), validators[3].consensusHex()},
+	}
+	client := rpc.NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+	c := NewUnifiedCollector(client, cfg, nil, &config.BlockTracking{ScanWindow: 10}, "")
+
+	// 겹치는 scrape: 캐시가 없으므로 각 Collect가 동시에 수집 (validatorStates 등 공유 상태)
+	const scrapes = 6
+	var wg sync.WaitGroup
+	monikers := make([]map[string]string, scrapes)
+	for i := 0; i < scrapes; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ch := make(chan prometheus.Metric)
+			go func() {
+				c.Collect(ch)
+				close(ch)
+			}()
+			monikers[i] = make(map[string]string)
+			for metric := range ch {
+				if labels, _, ok := metricSample(metric); ok && labels["moniker"] != "" {
+					monikers[i][labels["address"]] = labels["moniker"]
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for i, got := range monikers {
+		if got[validators[0].consensusHex()] != "validator-1" || got[validators[3].consensusHex()] != "validator-4" {
+			t.Errorf("scrape %d monikers = %v, want validator-1 and validator-4", i, got)
+		}
+	}
+	if moniker, ok := c.validatorMoniker(validators[3].consensusHex()); !ok || moniker != "validator-4" {
+		t.Errorf("validatorMoniker = %q, %v, want validator-4", moniker, ok)
+	}
+}
+
+// collectedSample is one metric emitted by a collection
+type collectedSample struct {
+	labels map[string]string
+	value  float64
+}
+
+// collectSamples runs one collection and returns the emitted samples by metric name
+func collectSamples(t *testing.T, c *UnifiedCollector) map[string][]collectedSample {
+	t.Helper()
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		c.collectAll(ch)
+		close(ch)
+	}()
+
+	samples := make(map[string][]collectedSample)
+	for metric := range ch {
+		descriptor, ok := parseDesc(metric.Desc())
+		if !ok {
+			continue
+		}
+		labels, value, ok := metricSample(metric)
+		if !ok {
+			continue
+		}
+		samples[descriptor.Name] = append(samples[descriptor.Name], collectedSample{labels: labels, value: value})
+	}
+	return samples
+}
+
+// sampleValue returns the value of the single sample of name whose labels include match
+func sampleValue(t *testing.T, samples map[string][]collectedSample, name string, match map[string]string) float64 {
+	t.Helper()
+	for _, sample := range samples[name] {
+		matched := true
+		for label, value := range match {
+			if sample.labels[label] != value {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return sample.value
+		}
+	}
+	t.Fatalf("no %s sample with labels %v", name, match)
+	return 0
+}
+
+// newFakeCollector returns a collector for a fakeNode serving validators, monitoring configured
+func newFakeCollector(t *testing.T, validators []fakeValidator, configured ...string) *UnifiedCollector {
+	t.Helper()
+	server := fakeNode(t, 1_200_000, validators)
+	cfg := &config.Chain{ChainID: "0g-fake-1", Name: "fake", Validators: configured}
+	client := rpc.NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+	return NewUnifiedCollector(client, cfg, nil, &config.BlockTracking{ScanWindow: 10}, "")
+}
+
+func TestCollectDistributionParams(t *testing.T) {
+	c := newFakeCollector(t, fakeValidators(2))
+	samples := collectSamples(t, c)
+
+	if got := sampleValue(t, samples, "cosmos_params_community_tax", nil); got != 0.02 {
+		t.Errorf("cosmos_params_community_tax = %v, want 0.02", got)
+	}
+	if got := sampleValue(t, samples, "cosmos_params_withdraw_addr_enabled", nil); got != 1 {
+		t.Errorf("cosmos_params_withdraw_addr_enabled = %v, want 1", got)
+	}
+}
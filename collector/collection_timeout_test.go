@@ -0,0 +1,49 @@
+package collector
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+)
+
+func TestScrapeTimeoutCounted(t *testing.T) {
+	node := fakeNode(t, 1_200_000, fakeValidators(2))
+	// /status가 응답하지 않는 노드 - 수집 deadline까지 대기
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/status" {
+			<-r.Context().Done()
+			return
+		}
+		node.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := &config.Chain{ChainID: "0g-fake-1", Name: "fake"}
+	client := rpc.NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+	c := NewUnifiedCollector(client, cfg, nil, &config.BlockTracking{ScanWindow: 10}, "")
+	c.SetCollectionTimeout(100 * time.Millisecond)
+
+	for want := 1.0; want <= 2; want++ {
+		start := time.Now()
+		samples := collectSamples(t, c)
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("collection took %v with a 100ms collection timeout", elapsed)
+		}
+		if got := sampleValue(t, samples, "zerog_scrape_timeout_total", nil); got != want {
+			t.Errorf("zerog_scrape_timeout_total = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestScrapeTimeoutNotCountedWithinDeadline(t *testing.T) {
+	c := newFakeCollector(t, fakeValidators(2))
+	c.SetCollectionTimeout(10 * time.Second)
+	samples := collectSamples(t, c)
+	if got := sampleValue(t, samples, "zerog_scrape_timeout_total", nil); got != 0 {
+		t.Errorf("zerog_scrape_timeout_total = %v, want 0", got)
+	}
+}
@@ -0,0 +1,62 @@
+package collector
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// collectConsensusStateMetrics emits the current consensus round, step and prevote power ratio
+func (c *UnifiedCollector) collectConsensusStateMetrics(ctx context.Context, ch chan<- prometheus.Metric) {
+	client := c.client.WithContext(ctx)
+	state, err := client.GetConsensusState()
+	if err != nil {
+		c.recordFetchError("consensus_state", err)
+		return
+	}
+
+	// "height/round/step" 예: "12345/0/6" (height는 label로 쓰지 않음 - 매 블록 시계열 생성 방지)
+	roundState := state.Result.RoundState
+	parts := strings.Split(roundState.HeightRoundStep, "/")
+	if len(parts) != 3 {
+		c.logger.Error("Unexpected consensus height/round/step", "value", roundState.HeightRoundStep)
+		return
+	}
+	round, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return
+	}
+	step, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.consensusRound, prometheus.GaugeValue, float64(round), c.cfg.ChainID)
+	ch <- prometheus.MustNewConstMetric(c.consensusStep, prometheus.GaugeValue, float64(step), c.cfg.ChainID)
+
+	for _, voteSet := range roundState.HeightVoteSet {
+		if voteSet.Round != round {
+			continue
+		}
+		if ratio, ok := parseVoteBitArrayRatio(voteSet.PrevotesBitArray); ok {
+			ch <- prometheus.MustNewConstMetric(c.consensusPrevotePowerRatio, prometheus.GaugeValue, ratio, c.cfg.ChainID)
+		}
+		break
+	}
+}
+
+// parseVoteBitArrayRatio extracts the voting power ratio from a vote bit array string such as
+// "BA{4:xx__} 200/400 = 0.50"
+func parseVoteBitArrayRatio(bitArray string) (float64, bool) {
+	idx := strings.LastIndex(bitArray, "=")
+	if idx < 0 {
+		return 0, false
+	}
+	ratio, err := strconv.ParseFloat(strings.TrimSpace(bitArray[idx+1:]), 64)
+	if err != nil {
+		return 0, false
+	}
+	return ratio, true
+}
@@ -0,0 +1,114 @@
+package collector
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// denomInfo describes how a base denom is displayed and scaled
+type denomInfo struct {
+	display  string
+	decimals int
+}
+
+// LoadDenomMetadata fetches the bank denom metadata and builds the denom -> (display, decimals) map
+// used to scale and label coin metrics. Denoms without metadata fall back to the configured token settings.
+func (c *UnifiedCollector) LoadDenomMetadata() error {
+	metadata, err := c.client.GetDenomsMetadata()
+	if err != nil {
+		return err
+	}
+
+	denoms := make(map[string]denomInfo)
+	for _, meta := range metadata.Metadatas {
+		info := denomInfo{display: meta.Display}
+		for _, unit := range meta.DenomUnits {
+			if unit.Denom == meta.Display {
+				info.decimals = unit.Exponent
+			}
+		}
+		if meta.Symbol != "" {
+			info.display = meta.Symbol
+		}
+		denoms[meta.Base] = info
+	}
+
+	c.mu.Lock()
+	c.denomMetadata = denoms
+	c.mu.Unlock()
+	return nil
+}
+
+// denomInfo returns the display name and decimals for a base denom. A denom_display entry overrides the
+// display name from the denom metadata or the configured token settings.
+func (c *UnifiedCollector) denomInfo(denom string) denomInfo {
+	c.mu.Lock()
+	info, ok := c.denomMetadata[denom]
+	bondDenom := c.bondDenom
+	c.mu.Unlock()
+	if !ok {
+		info = denomInfo{display: denom, decimals: c.cfg.TokenDecimals}
+		if c.cfg.TokenDisplay != "" && (denom == "" || denom == c.cfg.TokenBase || denom == bondDenom) {
+			info.display = c.cfg.TokenDisplay
+		}
+	}
+	if display, ok := c.cfg.DenomDisplay[denom]; ok && display != "" {
+		info.display = display
+	}
+	return info
+}
+
+// collectDenomInfo emits cosmos_denom_info, mapping each display denom label back to its base denom
+// for the staking denom, denoms with metadata and denom_display entries
+func (c *UnifiedCollector) collectDenomInfo(ch chan<- prometheus.Metric, stakingDenom string) {
+	bases := map[string]struct{}{}
+	if stakingDenom != "" {
+		bases[stakingDenom] = struct{}{}
+	}
+	c.mu.Lock()
+	for base := range c.denomMetadata {
+		bases[base] = struct{}{}
+	}
+	c.mu.Unlock()
+	for base := range c.cfg.DenomDisplay {
+		bases[base] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(bases))
+	for base := range bases {
+		sorted = append(sorted, base)
+	}
+	sort.Strings(sorted)
+	for _, base := range sorted {
+		ch <- prometheus.MustNewConstMetric(c.denomInfoDesc, prometheus.GaugeValue, 1, c.cfg.ChainID, c.denomInfo(base).display, base)
+	}
+}
+
+// denomAllowed reports whether supply / community pool metrics are emitted for a base denom.
+// include_denoms (when set) is an allowlist, ignore_denoms is applied after it; entries ending in "/" or "*" match by prefix.
+func (c *UnifiedCollector) denomAllowed(denom string) bool {
+	if len(c.cfg.IncludeDenoms) > 0 && !matchDenom(c.cfg.IncludeDenoms, denom) {
+		return false
+	}
+	return !matchDenom(c.cfg.IgnoreDenoms, denom)
+}
+
+func matchDenom(patterns []string, denom string) bool {
+	for _, pattern := range patterns {
+		switch {
+		case strings.HasSuffix(pattern, "*"):
+			if strings.HasPrefix(denom, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+		case strings.HasSuffix(pattern, "/"):
+			if strings.HasPrefix(denom, pattern) {
+				return true
+			}
+		case pattern == denom:
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package collector
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricDescriptor describes a metric the exporter can emit
+type MetricDescriptor struct {
+	Name   string   `json:"name"`
+	Help   string   `json:"help"`
+	Labels []string `json:"labels"`
+}
+
+var (
+	// descPattern matches prometheus.Desc.String(): Desc{fqName: "...", help: "...", constLabels: {...}, variableLabels: {...}}
+	descPattern       = regexp.MustCompile(`^Desc\{fqName: ("(?:[^"\\]|\\.)*"), help: ("(?:[^"\\]|\\.)*"), constLabels: \{(.*)\}, variableLabels: \{(.*)\}\}$`)
+	constLabelPattern = regexp.MustCompile(`(\w+)="(?:[^"\\]|\\.)*"`)
+)
+
+// DescribeMetrics collects the descriptors of the given collectors without querying anything, sorted by name
+func DescribeMetrics(collectors ...prometheus.Collector) []MetricDescriptor {
+	descCh := make(chan *prometheus.Desc)
+	go func() {
+		for _, c := range collectors {
+			c.Describe(descCh)
+		}
+		close(descCh)
+	}()
+
+	seen := make(map[string]bool)
+	var descriptors []MetricDescriptor
+	for desc := range descCh {
+		descriptor, ok := parseDesc(desc)
+		if !ok || seen[descriptor.Name] {
+			continue
+		}
+		seen[descriptor.Name] = true
+		descriptors = append(descriptors, descriptor)
+	}
+
+	sort.Slice(descriptors, func(i, j int) bool { return descriptors[i].Name < descriptors[j].Name })
+	return descriptors
+}
+
+// parseDesc extracts name, help and label names from a Desc (client_golang does not expose them directly)
+func parseDesc(desc *prometheus.Desc) (MetricDescriptor, bool) {
+	match := descPattern.FindStringSubmatch(desc.String())
+	if match == nil {
+		return MetricDescriptor{}, false
+	}
+
+	name, err := strconv.Unquote(match[1])
+	if err != nil {
+		return MetricDescriptor{}, false
+	}
+	help, err := strconv.Unquote(match[2])
+	if err != nil {
+		return MetricDescriptor{}, false
+	}
+
+	labels := []string{}
+	for _, constLabel := range constLabelPattern.FindAllStringSubmatch(match[3], -1) {
+		labels = append(labels, constLabel[1])
+	}
+	if match[4] != "" {
+		for _, label := range strings.Split(match[4], ",") {
+			// 제약 조건이 있는 label은 c(name) 형식
+			labels = append(labels, strings.TrimSuffix(strings.TrimPrefix(label, "c("), ")"))
+		}
+	}
+
+	return MetricDescriptor{Name: name, Help: help, Labels: labels}, true
+}
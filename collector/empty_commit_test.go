@@ -0,0 +1,101 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+)
+
+// emptyCommitCollector returns a collector for a fakeNode whose block at emptyHeight has "last_commit":{"signatures":[]}
+func emptyCommitCollector(t *testing.T, validators []fakeValidator, emptyHeight int64, configured ...string) *UnifiedCollector {
+	t.Helper()
+	node := fakeNode(t, 1_200_000, validators)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/block" || r.URL.Query().Get("height") != strconv.FormatInt(emptyHeight, 10) {
+			node.Config.Handler.ServeHTTP(w, r)
+			return
+		}
+		recorder := httptest.NewRecorder()
+		node.Config.Handler.ServeHTTP(recorder, r)
+		var payload map[string]interface{}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &payload); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		block := payload["result"].(map[string]interface{})["block"].(map[string]interface{})
+		block["last_commit"] = map[string]interface{}{"signatures": []interface{}{}}
+		json.NewEncoder(w).Encode(payload)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := &config.Chain{ChainID: "0g-fake-1", Name: "fake", Validators: configured}
+	client := rpc.NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+	return NewUnifiedCollector(client, cfg, nil, &config.BlockTracking{ScanWindow: 10}, "")
+}
+
+func TestScanSkipsEmptyCommit(t *testing.T) {
+	validators := fakeValidators(2)
+	validators[1].missing = true
+	// 1_199_995는 홀수 높이이므로 validator-2가 proposer
+	c := emptyCommitCollector(t, validators, 1_199_995, validators[0].consensusHex(), validators[1].consensusHex())
+	samples := collectSamples(t, c)
+
+	signer := map[string]string{"address": validators[0].consensusHex()}
+	misser := map[string]string{"address": validators[1].consensusHex()}
+	// 스캔한 10블록 중 빈 commit 블록은 signed / missed 집계에서 제외
+	if got := sampleValue(t, samples, "cosmos_validator_signed_blocks_total", signer); got != 9 {
+		t.Errorf("signed blocks = %v, want 9", got)
+	}
+	if got := sampleValue(t, samples, "cosmos_validator_missed_blocks_total", signer); got != 0 {
+		t.Errorf("missed blocks of the signer = %v, want 0", got)
+	}
+	if got := sampleValue(t, samples, "cosmos_validator_missed_blocks_total", misser); got != 9 {
+		t.Errorf("missed blocks = %v, want 9", got)
+	}
+	// proposer는 빈 commit 블록도 카운트 (홀수 높이 5개)
+	if got := sampleValue(t, samples, "cosmos_validator_blocks_proposed_total", misser); got != 5 {
+		t.Errorf("blocks proposed = %v, want 5", got)
+	}
+	if got := sampleValue(t, samples, "cosmos_validator_blocks_proposed_total", signer); got != 5 {
+		t.Errorf("blocks proposed by the signer = %v, want 5", got)
+	}
+}
+
+func TestBackfillSkipsEmptyCommit(t *testing.T) {
+	validators := fakeValidators(2)
+	validators[1].missing = true
+	signer, misser := validators[0].consensusHex(), validators[1].consensusHex()
+	c := emptyCommitCollector(t, validators, 1_199_995, signer, misser)
+
+	processed, err := c.Backfill(context.Background(), 1_199_991, 1_200_000)
+	if err != nil {
+		t.Fatalf("Backfill: %v", err)
+	}
+	if processed != 10 {
+		t.Errorf("processed = %d, want 10", processed)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if got := c.signedBlocksTotal[signer]; got != 9 {
+		t.Errorf("signed blocks = %v, want 9", got)
+	}
+	if got := c.missedBlocksTotal[signer]; got != 0 {
+		t.Errorf("missed blocks of the signer = %v, want 0", got)
+	}
+	if got := c.missedBlocksTotal[misser]; got != 9 {
+		t.Errorf("missed blocks = %v, want 9", got)
+	}
+	if got := c.proposedBlocksTotal[misser]; got != 5 {
+		t.Errorf("blocks proposed = %v, want 5", got)
+	}
+	if got := c.proposedBlocksTotal[signer]; got != 5 {
+		t.Errorf("blocks proposed by the signer = %v, want 5", got)
+	}
+}
@@ -0,0 +1,121 @@
+package collector
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"zerog-exporter/util"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
+)
+
+// endpointHeightCacheTTL is how long the heights of the compared RPC endpoints are reused between collections
+const endpointHeightCacheTTL = 5 * time.Second
+
+// endpointHeights are the latest heights reported by each compared RPC endpoint
+type endpointHeights struct {
+	fetchedAt time.Time
+	heights   map[string]int64
+}
+
+// heightEndpoints returns the RPC endpoints whose heights are compared: rpc, archive_rpc and extra_rpcs (deduplicated)
+func (c *UnifiedCollector) heightEndpoints() []string {
+	seen := make(map[string]bool)
+	var endpoints []string
+	for _, endpoint := range append([]string{c.cfg.RPC, c.cfg.ArchiveRPC}, c.cfg.ExtraRPCs...) {
+		if endpoint == "" || seen[endpoint] {
+			continue
+		}
+		seen[endpoint] = true
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints
+}
+
+// collectEndpointHeightMetrics queries /status of every compared RPC endpoint concurrently and emits
+// cosmos_endpoint_height per endpoint and, with at least two answering endpoints, cosmos_endpoint_height_spread.
+// Nothing is emitted when only the primary rpc is configured.
+func (c *UnifiedCollector) collectEndpointHeightMetrics(ctx context.Context, ch chan<- prometheus.Metric) {
+	endpoints := c.heightEndpoints()
+	if len(endpoints) < 2 {
+		return
+	}
+
+	c.mu.Lock()
+	cached := c.endpointHeights
+	c.mu.Unlock()
+
+	heights := cached.heights
+	if time.Since(cached.fetchedAt) > endpointHeightCacheTTL {
+		client := c.client.WithContext(ctx)
+		heights = make(map[string]int64, len(endpoints))
+		var mu sync.Mutex
+		var g errgroup.Group
+		for _, endpoint := range endpoints {
+			endpoint := endpoint
+			g.Go(func() error {
+				status, err := client.GetStatusFrom(endpoint)
+				if err != nil {
+					c.logger.Debug("Failed to get endpoint status", "chain_id", c.cfg.ChainID, "endpoint", endpoint, "error", err)
+					return nil
+				}
+				height, err := util.ParseBlockHeight(status.Result.SyncInfo.LatestBlockHeight)
+				if err != nil {
+					return nil
+				}
+				mu.Lock()
+				heights[endpoint] = height
+				mu.Unlock()
+				return nil
+			})
+		}
+		g.Wait()
+
+		// 수집 timeout으로 중단된 조회 결과는 endpoint 장애로 캐시하지 않음
+		if ctx.Err() == nil {
+			c.mu.Lock()
+			c.endpointHeights = endpointHeights{fetchedAt: time.Now(), heights: heights}
+			c.mu.Unlock()
+		}
+	}
+
+	var min, max int64
+	for _, endpoint := range endpoints {
+		height, ok := heights[endpoint]
+		if !ok {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.endpointHeight, prometheus.GaugeValue, float64(height), c.cfg.ChainID, endpoint)
+		if min == 0 || height < min {
+			min = height
+		}
+		if height > max {
+			max = height
+		}
+	}
+	if len(heights) >= 2 {
+		ch <- prometheus.MustNewConstMetric(c.endpointHeightSpread, prometheus.GaugeValue, float64(max-min), c.cfg.ChainID)
+	}
+}
+
+// endpointHealth returns the number of compared RPC endpoints and how many of them are unhealthy: the primary rpc
+// by primaryUp (the collector's own status query), the others by their last cached height query
+func (c *UnifiedCollector) endpointHealth(primaryUp bool) (total, unhealthy int) {
+	c.mu.Lock()
+	heights := c.endpointHeights.heights
+	c.mu.Unlock()
+
+	endpoints := c.heightEndpoints()
+	for _, endpoint := range endpoints {
+		healthy := primaryUp
+		if endpoint != c.cfg.RPC {
+			_, healthy = heights[endpoint]
+		}
+		if !healthy {
+			unhealthy++
+		}
+	}
+	return len(endpoints), unhealthy
+}
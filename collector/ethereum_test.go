@@ -0,0 +1,92 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+)
+
+// fakeEthereumNode answers eth JSON-RPC calls: every eth_call resolves to validatorAddress and every balance is 1.5 A0GI
+func fakeEthereumNode(t *testing.T, validatorAddress string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+			ID     int    `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var result string
+		switch request.Method {
+		case "eth_blockNumber":
+			result = "0x1c9c380"
+		case "eth_getBalance":
+			result = "0x14d1120d7b160000"
+		case "eth_call":
+			result = "0x000000000000000000000000" + strings.TrimPrefix(strings.ToLower(validatorAddress), "0x")
+		default:
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"error":{"code":-32601,"message":"the method %s does not exist/is not available"}}`, request.ID, request.Method)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%d,"result":"%s"}`, request.ID, result)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEthereumValidatorBalanceNotDuplicated(t *testing.T) {
+	const validatorAddress = "0x4B20993Bc481177ec7E8f571ceCaE8A9e22C02db"
+	server := fakeEthereumNode(t, validatorAddress)
+
+	cfg := &config.Chain{ChainID: "0g-galileo-testnet"}
+	ethCfg := &config.Ethereum{
+		RPCURL: server.URL,
+		// 같은 주소를 ethereum_addresses와 validators (pubkey)로 모두 설정
+		EthereumAddresses: []config.EthereumWallet{{Address: validatorAddress, Name: "validator-1"}},
+		Validators:        []config.EthereumValidator{{Pubkey: "0x02a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02ee0be551b5dc", Name: "validator-1"}},
+	}
+	c := NewUnifiedCollector(rpc.NewClient("http://127.0.0.1:26657", "http://127.0.0.1:1317", ""), cfg, ethCfg, nil, "")
+
+	ch := make(chan prometheus.Metric, 256)
+	if err := c.collectEthereumMetrics(context.Background(), ch); err != nil {
+		t.Fatalf("collectEthereumMetrics: %v", err)
+	}
+	close(ch)
+
+	balances := 0
+	statuses := 0
+	for metric := range ch {
+		descriptor, ok := parseDesc(metric.Desc())
+		if !ok {
+			continue
+		}
+		switch descriptor.Name {
+		case "eth_validator_balance":
+			balances++
+			if _, value, _ := metricSample(metric); value != 1.5 {
+				t.Errorf("eth_validator_balance = %v, want 1.5", value)
+			}
+		case "eth_validator_status":
+			statuses++
+		}
+	}
+	if balances != 1 {
+		t.Errorf("eth_validator_balance series = %d, want 1", balances)
+	}
+	if statuses != 1 {
+		t.Errorf("eth_validator_status series = %d, want 1", statuses)
+	}
+}
@@ -0,0 +1,38 @@
+package collector
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// EnableExemplars attaches exemplars to histogram observations: the id of the collection that produced them
+// (scrape_id) and, for block times, the block height. Exemplars are only exposed in the OpenMetrics format.
+func (c *UnifiedCollector) EnableExemplars() {
+	c.exemplars = true
+}
+
+// newScrapeID returns a random id identifying a single collection
+func newScrapeID() string {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(id)
+}
+
+// observe records value on histogram, with labels as the exemplar when exemplars are enabled
+func (c *UnifiedCollector) observe(histogram prometheus.Histogram, value float64, labels prometheus.Labels) {
+	if observer, ok := histogram.(prometheus.ExemplarObserver); ok && c.exemplars {
+		observer.ObserveWithExemplar(value, labels)
+		return
+	}
+	histogram.Observe(value)
+}
+
+// blockExemplar returns the exemplar labels of a block time observation
+func blockExemplar(scrapeID string, height int64) prometheus.Labels {
+	return prometheus.Labels{"scrape_id": scrapeID, "height": strconv.FormatInt(height, 10)}
+}
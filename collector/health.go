@@ -0,0 +1,92 @@
+package collector
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"zerog-exporter/config"
+)
+
+// Health score defaults used for unset validator_health values
+const (
+	defaultHealthMinSigningRatio      = 0.95
+	defaultHealthMaxConsecutiveMissed = 10
+)
+
+// healthComponents are the pass/fail inputs of cosmos_validator_health
+type healthComponents struct {
+	activeNotJailed     bool
+	signingRatioOK      bool
+	consecutiveMissedOK bool
+	notTombstoned       bool
+}
+
+// healthScore returns the weighted share of passing components (0-1). All weights unset = equal weights.
+func healthScore(components healthComponents, weights config.HealthWeights) float64 {
+	if weights.Active == 0 && weights.Signing == 0 && weights.ConsecutiveMissed == 0 && weights.Tombstoned == 0 {
+		weights = config.HealthWeights{Active: 1, Signing: 1, ConsecutiveMissed: 1, Tombstoned: 1}
+	}
+
+	total := weights.Active + weights.Signing + weights.ConsecutiveMissed + weights.Tombstoned
+	if total <= 0 {
+		return 0
+	}
+
+	score := 0.0
+	if components.activeNotJailed {
+		score += weights.Active
+	}
+	if components.signingRatioOK {
+		score += weights.Signing
+	}
+	if components.consecutiveMissedOK {
+		score += weights.ConsecutiveMissed
+	}
+	if components.notTombstoned {
+		score += weights.Tombstoned
+	}
+	return score / total
+}
+
+// collectValidatorHealth emits the composite health score of a validator and its component booleans
+func (c *UnifiedCollector) collectValidatorHealth(ch chan<- prometheus.Metric, validatorAddr, moniker string, stats validatorBlockStats, inActiveSet, jailed, tombstoned bool) {
+	healthCfg := c.cfg.ValidatorHealth
+	minSigningRatio := healthCfg.MinSigningRatio
+	if minSigningRatio <= 0 {
+		minSigningRatio = defaultHealthMinSigningRatio
+	}
+	maxConsecutiveMissed := healthCfg.MaxConsecutiveMissed
+	if maxConsecutiveMissed <= 0 {
+		maxConsecutiveMissed = defaultHealthMaxConsecutiveMissed
+	}
+
+	// 최근 스캔 윈도우 기준 서명 비율 (블록 없으면 통과로 간주)
+	signingRatio := 1.0
+	if scanned := stats.signedBlocks + stats.missedBlocks; scanned > 0 {
+		signingRatio = float64(stats.signedBlocks) / float64(scanned)
+	}
+
+	// 블록 트래커가 있으면 실시간 연속 미스, 없으면 최근 블록부터 이어지는 스캔 윈도우의 연속 미스
+	consecutiveMissed := c.currentConsecutiveMissed(validatorAddr, stats)
+
+	components := healthComponents{
+		activeNotJailed:     inActiveSet && !jailed,
+		signingRatioOK:      signingRatio >= minSigningRatio,
+		consecutiveMissedOK: consecutiveMissed < maxConsecutiveMissed,
+		notTombstoned:       !tombstoned,
+	}
+
+	for component, ok := range map[string]bool{
+		"active":             components.activeNotJailed,
+		"signing_ratio":      components.signingRatioOK,
+		"consecutive_missed": components.consecutiveMissedOK,
+		"not_tombstoned":     components.notTombstoned,
+	} {
+		value := 0.0
+		if ok {
+			value = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.validatorHealthComponent, prometheus.GaugeValue, value, c.cfg.ChainID, validatorAddr, moniker, component)
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.validatorHealth, prometheus.GaugeValue, healthScore(components, healthCfg.Weights), c.cfg.ChainID, validatorAddr, moniker)
+}
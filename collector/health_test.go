@@ -0,0 +1,62 @@
+package collector
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+)
+
+// healthComponentValues runs collectValidatorHealth and returns the component gauges by component label
+func healthComponentValues(t *testing.T, c *UnifiedCollector, stats validatorBlockStats) map[string]float64 {
+	t.Helper()
+
+	ch := make(chan prometheus.Metric, 16)
+	c.collectValidatorHealth(ch, "0gvaloper1abc", "validator-1", stats, true, false, false)
+	close(ch)
+
+	values := make(map[string]float64)
+	for metric := range ch {
+		descriptor, ok := parseDesc(metric.Desc())
+		if !ok || descriptor.Name != "cosmos_validator_health_component" {
+			continue
+		}
+		labels, value, ok := metricSample(metric)
+		if !ok {
+			t.Fatalf("unreadable health component metric")
+		}
+		values[labels["component"]] = value
+	}
+	return values
+}
+
+func TestValidatorHealthUsesTrailingStreak(t *testing.T) {
+	cfg := &config.Chain{ChainID: "0g-galileo-testnet"}
+	c := NewUnifiedCollector(rpc.NewClient("http://127.0.0.1:26657", "http://127.0.0.1:1317", ""), cfg, nil, nil, "")
+
+	// 최근 200블록은 서명, 그 이전에 20블록 연속 miss (기본 max_consecutive_missed = 10)
+	var recovered validatorBlockStats
+	for i := 0; i < 200; i++ {
+		recovered.recordSignature(true)
+	}
+	for i := 0; i < 20; i++ {
+		recovered.recordSignature(false)
+	}
+	if got := healthComponentValues(t, c, recovered)["consecutive_missed"]; got != 1 {
+		t.Errorf("recovered validator consecutive_missed = %v, want 1", got)
+	}
+
+	// 최근 12블록 연속 miss
+	var missing validatorBlockStats
+	for i := 0; i < 12; i++ {
+		missing.recordSignature(false)
+	}
+	for i := 0; i < 200; i++ {
+		missing.recordSignature(true)
+	}
+	if got := healthComponentValues(t, c, missing)["consecutive_missed"]; got != 0 {
+		t.Errorf("missing validator consecutive_missed = %v, want 0", got)
+	}
+}
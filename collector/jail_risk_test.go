@@ -0,0 +1,96 @@
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"zerog-exporter/util"
+)
+
+func TestJailThreshold(t *testing.T) {
+	tests := []struct {
+		name               string
+		signedBlocksWindow float64
+		minSignedPerWindow float64
+		want               float64
+	}{
+		{name: "cosmos hub", signedBlocksWindow: 10000, minSignedPerWindow: 0.05, want: 9500},
+		{name: "0g galileo", signedBlocksWindow: 1000, minSignedPerWindow: 0.5, want: 500},
+		{name: "inexact decimal", signedBlocksWindow: 100, minSignedPerWindow: 0.9, want: 10},
+		{name: "fractional rounds down", signedBlocksWindow: 25000, minSignedPerWindow: 0.95001, want: 1249},
+		{name: "all blocks required", signedBlocksWindow: 100, minSignedPerWindow: 1, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jailThreshold(tt.signedBlocksWindow, tt.minSignedPerWindow); got != tt.want {
+				t.Errorf("jailThreshold(%v, %v) = %v, want %v", tt.signedBlocksWindow, tt.minSignedPerWindow, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordSignatureTrailingStreak(t *testing.T) {
+	// newest -> oldest: 3 missed, 1 signed, 5 missed, 1 signed
+	scan := []bool{false, false, false, true, false, false, false, false, false, true}
+
+	var stats validatorBlockStats
+	for _, signed := range scan {
+		stats.recordSignature(signed)
+	}
+
+	if stats.trailingMissed != 3 {
+		t.Errorf("trailingMissed = %d, want 3", stats.trailingMissed)
+	}
+	if stats.maxConsecutiveMissed != 5 {
+		t.Errorf("maxConsecutiveMissed = %d, want 5", stats.maxConsecutiveMissed)
+	}
+	if stats.signedBlocks != 2 || stats.missedBlocks != 8 {
+		t.Errorf("signed/missed = %d/%d, want 2/8", stats.signedBlocks, stats.missedBlocks)
+	}
+
+	// tracker 없이: jail 위험도는 과거 최장 streak가 아니라 현재 streak 기준
+	c := &UnifiedCollector{}
+	threshold := jailThreshold(10000, 0.05)
+	if risk := float64(c.currentConsecutiveMissed("0gvaloper1abc", stats)) / threshold; risk != 3.0/9500 {
+		t.Errorf("jail risk = %v, want %v", risk, 3.0/9500)
+	}
+}
+
+func TestRecordSignatureRecovered(t *testing.T) {
+	// 최근 블록은 서명, 과거에 긴 miss streak
+	var stats validatorBlockStats
+	stats.recordSignature(true)
+	for i := 0; i < 50; i++ {
+		stats.recordSignature(false)
+	}
+
+	if stats.trailingMissed != 0 {
+		t.Errorf("trailingMissed = %d, want 0", stats.trailingMissed)
+	}
+	if stats.maxConsecutiveMissed != 50 {
+		t.Errorf("maxConsecutiveMissed = %d, want 50", stats.maxConsecutiveMissed)
+	}
+}
+
+func TestCalculateDowntimeThreshold(t *testing.T) {
+	tests := []struct {
+		name         string
+		downtimeJail float64
+		blockTime    time.Duration
+		want         int
+	}{
+		{name: "600s at 1s blocks", downtimeJail: 600, blockTime: time.Second, want: 600},
+		{name: "600s at 6s blocks", downtimeJail: 600, blockTime: 6 * time.Second, want: 100},
+		{name: "600s at 1.5s blocks", downtimeJail: 600, blockTime: 1500 * time.Millisecond, want: 400},
+		{name: "shorter than a block", downtimeJail: 1, blockTime: 6 * time.Second, want: 1},
+		{name: "unknown block time", downtimeJail: 600, blockTime: 0, want: 0},
+		{name: "no jail duration", downtimeJail: 0, blockTime: time.Second, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.CalculateDowntimeThreshold(tt.downtimeJail, tt.blockTime); got != tt.want {
+				t.Errorf("CalculateDowntimeThreshold(%v, %v) = %d, want %d", tt.downtimeJail, tt.blockTime, got, tt.want)
+			}
+		})
+	}
+}
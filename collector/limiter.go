@@ -0,0 +1,25 @@
+package collector
+
+// ConcurrencyLimiter caps the number of chains collecting at the same time.
+// A nil limiter does not limit.
+type ConcurrencyLimiter chan struct{}
+
+// NewConcurrencyLimiter creates a limiter allowing max concurrent collections (max <= 0 = unlimited)
+func NewConcurrencyLimiter(max int) ConcurrencyLimiter {
+	if max <= 0 {
+		return nil
+	}
+	return make(ConcurrencyLimiter, max)
+}
+
+func (l ConcurrencyLimiter) acquire() {
+	if l != nil {
+		l <- struct{}{}
+	}
+}
+
+func (l ConcurrencyLimiter) release() {
+	if l != nil {
+		<-l
+	}
+}
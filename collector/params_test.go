@@ -0,0 +1,83 @@
+package collector
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+)
+
+// newFakeCollectorWithSlashingParams returns a collector for a fakeNode whose slashing params endpoint serves payload
+func newFakeCollectorWithSlashingParams(t *testing.T, payload string) *UnifiedCollector {
+	t.Helper()
+	node := fakeNode(t, 1_200_000, fakeValidators(2))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/cosmos/slashing/v1beta1/params" {
+			fmt.Fprint(w, payload)
+			return
+		}
+		node.Config.Handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := &config.Chain{ChainID: "0g-fake-1", Name: "fake"}
+	client := rpc.NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+	return NewUnifiedCollector(client, cfg, nil, &config.BlockTracking{ScanWindow: 10}, "")
+}
+
+func TestCollectDowntimeJailDuration(t *testing.T) {
+	for _, duration := range []string{"600s", "600000000000"} {
+		t.Run(duration, func(t *testing.T) {
+			c := newFakeCollectorWithSlashingParams(t, `{"params":{"signed_blocks_window":"10000","min_signed_per_window":"0.050000000000000000","downtime_jail_duration":"`+duration+`","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.000100000000000000"}}`)
+			samples := collectSamples(t, c)
+			if got := sampleValue(t, samples, "cosmos_params_downtime_jail_duration", nil); got != 600 {
+				t.Errorf("cosmos_params_downtime_jail_duration = %v, want 600", got)
+			}
+		})
+	}
+}
+
+func TestCollectSlashingParams(t *testing.T) {
+	// amino JSON 형식 (duration이 나노초 정수)
+	c := newFakeCollectorWithSlashingParams(t, `{
+  "params": {
+    "signed_blocks_window": "10000",
+    "min_signed_per_window": "0.050000000000000000",
+    "downtime_jail_duration": "600000000000",
+    "slash_fraction_double_sign": "0.050000000000000000",
+    "slash_fraction_downtime": "0.000100000000000000"
+  }
+}`)
+	samples := collectSamples(t, c)
+
+	want := map[string]float64{
+		"cosmos_params_signed_blocks_window":       10000,
+		"cosmos_params_min_signed_per_window":      0.05,
+		"cosmos_params_downtime_jail_duration":     600,
+		"cosmos_params_slash_fraction_double_sign": 0.05,
+		"cosmos_params_slash_fraction_downtime":    0.0001,
+	}
+	for name, value := range want {
+		if got := sampleValue(t, samples, name, nil); got != value {
+			t.Errorf("%s = %v, want %v", name, got, value)
+		}
+	}
+}
+
+func TestCollectSlashingParamsMalformedField(t *testing.T) {
+	// 파싱할 수 없는 필드는 해당 메트릭만 생략
+	c := newFakeCollectorWithSlashingParams(t, `{"params":{"signed_blocks_window":"10000","min_signed_per_window":"0.050000000000000000","downtime_jail_duration":"ten minutes","slash_fraction_double_sign":"","slash_fraction_downtime":"0.000100000000000000"}}`)
+	samples := collectSamples(t, c)
+
+	if got := sampleValue(t, samples, "cosmos_params_signed_blocks_window", nil); got != 10000 {
+		t.Errorf("cosmos_params_signed_blocks_window = %v, want 10000", got)
+	}
+	for _, name := range []string{"cosmos_params_downtime_jail_duration", "cosmos_params_slash_fraction_double_sign"} {
+		if len(samples[name]) != 0 {
+			t.Errorf("%s emitted for a malformed value: %v", name, samples[name])
+		}
+	}
+}
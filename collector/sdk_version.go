@@ -0,0 +1,59 @@
+package collector
+
+import (
+	"strconv"
+	"strings"
+
+	"zerog-exporter/rpc"
+	"zerog-exporter/util"
+)
+
+// DetectSDKVersion reads the Cosmos SDK version from node_info. A configured sdk_version always takes precedence.
+func (c *UnifiedCollector) DetectSDKVersion() error {
+	nodeInfo, err := c.client.GetNodeInfo()
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.sdkVersion = nodeInfo.ApplicationVersion.CosmosSDKVersion
+	c.mu.Unlock()
+	c.logger.Info("Detected Cosmos SDK version", "chain_id", c.cfg.ChainID, "version", nodeInfo.ApplicationVersion.CosmosSDKVersion)
+	return nil
+}
+
+// isSDKv050OrLater reports whether the chain runs Cosmos SDK v0.50 or later (false when unknown)
+func (c *UnifiedCollector) isSDKv050OrLater() bool {
+	version := c.cfg.SDKVersion
+	if version == "" {
+		c.mu.Lock()
+		version = c.sdkVersion
+		c.mu.Unlock()
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+	minor, err := strconv.Atoi(strings.SplitN(parts[1], "-", 2)[0])
+	if err != nil {
+		return false
+	}
+	return major > 0 || minor >= 50
+}
+
+// validatorConsensusAddress returns the hex consensus address of a staking validator. SDK v0.50+ no longer
+// returns a consensus_address field, so it is derived from the consensus pubkey (by key type) there or whenever
+// the field is missing.
+func (c *UnifiedCollector) validatorConsensusAddress(consensusAddress string, pubkey rpc.ConsensusPubkey) string {
+	if (consensusAddress == "" || c.isSDKv050OrLater()) && pubkey.Key != "" {
+		if derived := util.GenerateConsensusAddressFromTypedPubkey(pubkey.Type, pubkey.Key); derived != "" {
+			return derived
+		}
+	}
+	return consensusAddress
+}
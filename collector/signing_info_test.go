@@ -0,0 +1,54 @@
+package collector
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCollectSigningInfo(t *testing.T) {
+	validators := fakeValidators(2)
+	// validator-1: double sign으로 tombstone, 영구 jail
+	validators[0].tombstoned = true
+	validators[0].jailed = true
+	validators[0].status = "BOND_STATUS_UNBONDING"
+	validators[0].jailedUntil = "9999-12-31T23:59:59Z"
+	validators[0].startHeight = 1520033
+	// validator-2: downtime jail 후 unjail
+	validators[1].jailedUntil = "2026-10-16T12:10:00.123456789Z"
+	validators[1].startHeight = 3297317
+	validators[1].missedCounter = 37
+
+	c := newFakeCollector(t, validators, validators[0].consensusHex(), validators[1].consensusHex())
+	samples := collectSamples(t, c)
+
+	tombstoned := map[string]string{"address": validators[0].consensusHex()}
+	unjailed := map[string]string{"address": validators[1].consensusHex()}
+
+	if got := sampleValue(t, samples, "cosmos_validator_tombstoned", tombstoned); got != 1 {
+		t.Errorf("tombstoned validator cosmos_validator_tombstoned = %v, want 1", got)
+	}
+	if got := sampleValue(t, samples, "cosmos_validator_tombstoned", unjailed); got != 0 {
+		t.Errorf("unjailed validator cosmos_validator_tombstoned = %v, want 0", got)
+	}
+
+	foreverJailed := time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC).Unix()
+	if got := sampleValue(t, samples, "cosmos_validator_jailed_until_seconds", tombstoned); got != float64(foreverJailed) {
+		t.Errorf("tombstoned validator jailed_until = %v, want %d", got, foreverJailed)
+	}
+	jailedUntil := time.Date(2026, 10, 16, 12, 10, 0, 0, time.UTC).Unix()
+	if got := sampleValue(t, samples, "cosmos_validator_jailed_until_seconds", unjailed); got != float64(jailedUntil) {
+		t.Errorf("unjailed validator jailed_until = %v, want %d", got, jailedUntil)
+	}
+
+	if got := sampleValue(t, samples, "cosmos_validator_signing_start_height", tombstoned); got != 1520033 {
+		t.Errorf("tombstoned validator signing_start_height = %v, want 1520033", got)
+	}
+	if got := sampleValue(t, samples, "cosmos_validator_signing_start_height", unjailed); got != 3297317 {
+		t.Errorf("unjailed validator signing_start_height = %v, want 3297317", got)
+	}
+
+	// missed_blocks_counter 37 / signed_blocks_window 10000
+	if got := sampleValue(t, samples, "cosmos_validator_uptime_ratio", unjailed); got != (10000.0-37)/10000 {
+		t.Errorf("uptime ratio = %v, want %v", got, (10000.0-37)/10000)
+	}
+}
@@ -0,0 +1,41 @@
+package collector
+
+import "zerog-exporter/config"
+
+// successWindow keeps the outcome of the last size collections as a ring buffer. Not safe for concurrent use
+type successWindow struct {
+	results   []bool
+	next      int
+	succeeded int
+}
+
+func newSuccessWindow(size int) *successWindow {
+	if size <= 0 {
+		size = config.DefaultSuccessRatioWindow
+	}
+	return &successWindow{results: make([]bool, 0, size)}
+}
+
+// record adds the outcome of a collection, evicting the oldest one once the window is full
+func (w *successWindow) record(success bool) {
+	if len(w.results) < cap(w.results) {
+		w.results = append(w.results, success)
+	} else {
+		if w.results[w.next] {
+			w.succeeded--
+		}
+		w.results[w.next] = success
+		w.next = (w.next + 1) % len(w.results)
+	}
+	if success {
+		w.succeeded++
+	}
+}
+
+// ratio returns the share of successful collections in the window (false before the first collection)
+func (w *successWindow) ratio() (float64, bool) {
+	if len(w.results) == 0 {
+		return 0, false
+	}
+	return float64(w.succeeded) / float64(len(w.results)), true
+}
@@ -0,0 +1,183 @@
+package collector
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// tenderdutyLabels are the labels of every per-validator tenderduty metric (name is the chain name)
+var tenderdutyLabels = []string{"name", "chain_id", "moniker"}
+
+// tenderdutyMapping translates a native per-validator metric into a tenderduty metric
+type tenderdutyMapping struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+	convert   func(float64) float64 // nil = value as is
+}
+
+// TenderdutyCollector exports only tenderduty (v2) compatible metric names and labels, translated from the
+// native metrics of a UnifiedCollector, for dashboards and alerts migrated from tenderduty (tenderduty_compat).
+// The wrapped collector keeps collecting as usual; its native metrics are not exported
+type TenderdutyCollector struct {
+	native *UnifiedCollector
+
+	// native 메트릭 이름 -> tenderduty 메트릭 (validator별)
+	validatorMappings map[string]tenderdutyMapping
+	// native chain 메트릭 이름 -> tenderduty 메트릭 (validator별로 복제)
+	chainMappings map[string]tenderdutyMapping
+
+	totalEndpoints     *prometheus.Desc
+	unhealthyEndpoints *prometheus.Desc
+}
+
+// NewTenderdutyCollector wraps a UnifiedCollector so it is exported under tenderduty metric names
+func NewTenderdutyCollector(native *UnifiedCollector) *TenderdutyCollector {
+	newDesc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(name, help, tenderdutyLabels, nil)
+	}
+	isOne := func(value float64) float64 {
+		if value == 1 {
+			return 1
+		}
+		return 0
+	}
+	isBonded := func(status float64) float64 {
+		// cosmos_validator_status: 3 = BOND_STATUS_BONDED
+		if status == 3 {
+			return 1
+		}
+		return 0
+	}
+
+	return &TenderdutyCollector{
+		native: native,
+		validatorMappings: map[string]tenderdutyMapping{
+			"cosmos_validator_signed_blocks_total":   {desc: newDesc("tenderduty_signed_blocks", "count of blocks signed since tenderduty was started"), valueType: prometheus.CounterValue},
+			"cosmos_validator_blocks_proposed_total": {desc: newDesc("tenderduty_proposed_blocks", "count of blocks proposed since tenderduty was started"), valueType: prometheus.CounterValue},
+			"cosmos_validator_missed_blocks_total":   {desc: newDesc("tenderduty_missed_blocks", "count of blocks missed since tenderduty was started"), valueType: prometheus.CounterValue},
+			"cosmos_validator_consecutive_missed":    {desc: newDesc("tenderduty_consecutive_missed_blocks", "the current count of consecutively missed blocks regardless of precommit or prevote status"), valueType: prometheus.GaugeValue},
+			"cosmos_validator_missed_blocks":         {desc: newDesc("tenderduty_missed_blocks_window", "the current count of missed blocks in the slashing window"), valueType: prometheus.GaugeValue},
+			"cosmos_validator_status":                {desc: newDesc("tenderduty_validator_bonded", "if the validator is in the bonded state"), valueType: prometheus.GaugeValue, convert: isBonded},
+			"cosmos_validator_jailed_status":         {desc: newDesc("tenderduty_validator_jailed", "if the validator is jailed"), valueType: prometheus.GaugeValue, convert: isOne},
+			"cosmos_validator_tombstoned":            {desc: newDesc("tenderduty_validator_tombstoned", "if the validator is tombstoned"), valueType: prometheus.GaugeValue, convert: isOne},
+		},
+		chainMappings: map[string]tenderdutyMapping{
+			"cosmos_params_signed_blocks_window": {desc: newDesc("tenderduty_slashing_window_size", "the size of the slashing window"), valueType: prometheus.GaugeValue},
+			"cosmos_time_since_last_block":       {desc: newDesc("tenderduty_last_block_seconds", "how many seconds since the previous block was finalized"), valueType: prometheus.GaugeValue},
+		},
+		totalEndpoints:     prometheus.NewDesc("tenderduty_total_monitored_endpoints", "the count of rpc endpoints being monitored for a chain", []string{"name", "chain_id"}, nil),
+		unhealthyEndpoints: prometheus.NewDesc("tenderduty_total_unhealthy_endpoints", "the count of unhealthy rpc endpoints being monitored for a chain", []string{"name", "chain_id"}, nil),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (t *TenderdutyCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, mapping := range t.validatorMappings {
+		ch <- mapping.desc
+	}
+	for _, mapping := range t.chainMappings {
+		ch <- mapping.desc
+	}
+	ch <- t.totalEndpoints
+	ch <- t.unhealthyEndpoints
+}
+
+// Collect implements prometheus.Collector
+func (t *TenderdutyCollector) Collect(ch chan<- prometheus.Metric) {
+	nativeCh := make(chan prometheus.Metric, 256)
+	go func() {
+		t.native.Collect(nativeCh)
+		close(nativeCh)
+	}()
+
+	// moniker label이 없는 native 메트릭용으로 address -> moniker를 먼저 모음
+	type sample struct {
+		name   string
+		labels map[string]string
+		value  float64
+	}
+	var samples []sample
+	monikers := make(map[string]string)
+	for metric := range nativeCh {
+		descriptor, ok := parseDesc(metric.Desc())
+		if !ok {
+			continue
+		}
+		if _, exists := t.validatorMappings[descriptor.Name]; !exists {
+			if _, exists := t.chainMappings[descriptor.Name]; !exists && descriptor.Name != "cosmos_td_up" {
+				continue
+			}
+		}
+		labels, value, ok := metricSample(metric)
+		if !ok {
+			continue
+		}
+		if moniker := labels["moniker"]; moniker != "" && labels["address"] != "" {
+			monikers[labels["address"]] = moniker
+		}
+		samples = append(samples, sample{name: descriptor.Name, labels: labels, value: value})
+	}
+
+	chainName := strings.TrimSpace(t.native.cfg.Name)
+	chainID := t.native.cfg.ChainID
+	monikerOf := func(address string) string {
+		if moniker, ok := monikers[address]; ok {
+			return moniker
+		}
+		if moniker, ok := t.native.validatorMoniker(address); ok {
+			return moniker
+		}
+		return "Unknown"
+	}
+
+	primaryUp := false
+	for _, s := range samples {
+		if s.name == "cosmos_td_up" {
+			primaryUp = s.value == 1
+			continue
+		}
+		if mapping, ok := t.validatorMappings[s.name]; ok {
+			ch <- prometheus.MustNewConstMetric(mapping.desc, mapping.valueType, mapping.apply(s.value), chainName, chainID, monikerOf(s.labels["address"]))
+			continue
+		}
+		// chain 단위 값은 tenderduty처럼 validator별 series로 emit
+		mapping := t.chainMappings[s.name]
+		for _, validatorAddr := range t.native.cfg.Validators {
+			ch <- prometheus.MustNewConstMetric(mapping.desc, mapping.valueType, mapping.apply(s.value), chainName, chainID, monikerOf(validatorAddr))
+		}
+	}
+
+	// primary rpc는 수집 시 status 조회 결과 (cosmos_td_up), 나머지는 endpoint height 캐시 기준
+	totalEndpoints, unhealthyEndpoints := t.native.endpointHealth(primaryUp)
+	ch <- prometheus.MustNewConstMetric(t.totalEndpoints, prometheus.GaugeValue, float64(totalEndpoints), chainName, chainID)
+	ch <- prometheus.MustNewConstMetric(t.unhealthyEndpoints, prometheus.GaugeValue, float64(unhealthyEndpoints), chainName, chainID)
+}
+
+func (m tenderdutyMapping) apply(value float64) float64 {
+	if m.convert == nil {
+		return value
+	}
+	return m.convert(value)
+}
+
+// metricSample returns the label values and the gauge / counter value of a metric
+func metricSample(metric prometheus.Metric) (map[string]string, float64, bool) {
+	var out dto.Metric
+	if err := metric.Write(&out); err != nil {
+		return nil, 0, false
+	}
+
+	labels := make(map[string]string, len(out.GetLabel()))
+	for _, pair := range out.GetLabel() {
+		labels[pair.GetName()] = pair.GetValue()
+	}
+	switch {
+	case out.Gauge != nil:
+		return labels, out.GetGauge().GetValue(), true
+	case out.Counter != nil:
+		return labels, out.GetCounter().GetValue(), true
+	}
+	return nil, 0, false
+}
@@ -0,0 +1,100 @@
+package collector
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+	"zerog-exporter/util"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentUnbondingQueries bounds the number of validator unbonding queries run at the same time
+const maxConcurrentUnbondingQueries = 8
+
+// collectUnbondingMetrics emits cosmos_validator_unbonding_total for the validators selected by unbonding_scope
+// (configured validators by default) and, for scope "all", the chain-wide cosmos_unbonding_total
+func (c *UnifiedCollector) collectUnbondingMetrics(ctx context.Context, ch chan<- prometheus.Metric, validators *rpc.ValidatorsResponse, stakingDenomInfo denomInfo) {
+	client := c.client.WithContext(ctx)
+	scope := c.cfg.UnbondingScope
+	if scope == config.UnbondingScopeNone {
+		return
+	}
+
+	// canonical hex -> 설정된 주소 (다른 validator 메트릭과 같은 address label 사용)
+	configured := make(map[string]string, len(c.cfg.Validators))
+	for _, validatorAddr := range c.cfg.Validators {
+		configured[c.consensusHex(validatorAddr)] = validatorAddr
+	}
+
+	type target struct {
+		address  string
+		moniker  string
+		operator string
+	}
+	var targets []target
+	for _, validator := range validators.Validators {
+		address := c.validatorConsensusAddress(validator.ConsensusAddress, validator.ConsensusPubkey)
+		if hexAddr, err := util.NormalizeConsensusAddress(address); err == nil {
+			address = hexAddr
+		}
+		if configuredAddr, ok := configured[address]; ok {
+			address = configuredAddr
+		} else if scope != config.UnbondingScopeAll {
+			continue
+		}
+		targets = append(targets, target{address: address, moniker: validator.Description.Moniker, operator: validator.OperatorAddress})
+	}
+
+	totals := make([]*big.Float, len(targets))
+	var failedMu sync.Mutex
+	failed := false
+
+	var g errgroup.Group
+	g.SetLimit(maxConcurrentUnbondingQueries)
+	for i, t := range targets {
+		i, operator := i, t.operator
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				return nil
+			}
+			unbonding, err := client.GetValidatorUnbonding(operator)
+			if err != nil {
+				c.recordFetchError("validator_unbonding", err)
+				failedMu.Lock()
+				failed = true
+				failedMu.Unlock()
+				return nil
+			}
+			total := new(big.Float)
+			for _, ub := range unbonding.UnbondingResponses {
+				for _, entry := range ub.Entries {
+					if amount, err := util.ParseAmount(entry.Balance); err == nil {
+						total.Add(total, amount)
+					}
+				}
+			}
+			totals[i] = total
+			return nil
+		})
+	}
+	g.Wait()
+
+	chainTotal := new(big.Float)
+	for i, t := range targets {
+		if totals[i] == nil {
+			continue
+		}
+		chainTotal.Add(chainTotal, totals[i])
+		ch <- prometheus.MustNewConstMetric(c.validatorUnbondingTotal, prometheus.GaugeValue, util.ScaleBigAmount(totals[i], stakingDenomInfo.decimals), c.cfg.ChainID, t.address, t.moniker, stakingDenomInfo.display)
+	}
+
+	// 일부 validator 조회가 실패하거나 목록이 잘렸으면 (max_validators) 합계가 과소 집계되므로 emit하지 않음
+	if scope == config.UnbondingScopeAll && !failed && !validators.Truncated && ctx.Err() == nil {
+		ch <- prometheus.MustNewConstMetric(c.unbondingTotal, prometheus.GaugeValue, util.ScaleBigAmount(chainTotal, stakingDenomInfo.decimals), c.cfg.ChainID, stakingDenomInfo.display)
+	}
+}
@@ -2,8 +2,16 @@ package collector
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"math"
+	"math/big"
+	"net/http"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -13,39 +21,49 @@ import (
 	"zerog-exporter/util"
 )
 
-// Simple logger for the collector
-type Logger struct{}
-
-// convertFromBaseUnit converts from base unit (e.g., 36000000000) to display unit (e.g., 36)
-func convertFromBaseUnit(baseAmount int64, decimals int) float64 {
-	if decimals == 0 {
-		return float64(baseAmount)
-	}
-	return float64(baseAmount) / math.Pow10(decimals)
+// Logger is the collector's logger, backed by the slog.Logger set with SetLogger (no-op until then)
+type Logger struct {
+	slog *slog.Logger
 }
 
-// convertFromBaseUnitFloat converts from base unit using float64 to handle large numbers
-func convertFromBaseUnitFloat(baseAmount float64, decimals int) float64 {
-	if decimals == 0 {
-		return baseAmount
+// secondsPerYear is the length of a 365.25-day year used for per-block provision estimates
+const secondsPerYear = 365.25 * 24 * 60 * 60
+
+
+// defaultBlockTimeBuckets are the cosmos_block_time_seconds buckets used when block_time_buckets is not configured
+var defaultBlockTimeBuckets = []float64{0.5, 1, 1.5, 2, 3, 5, 7.5, 10, 30, 60}
+
+// convertHexFromBaseUnit converts a 0x-prefixed hex quantity (e.g. wei) to display unit using big.Float to avoid overflow
+func convertHexFromBaseUnit(hexAmount string, decimals int) (float64, error) {
+	amount, ok := new(big.Int).SetString(strings.TrimPrefix(hexAmount, "0x"), 16)
+	if !ok {
+		return 0, fmt.Errorf("invalid hex quantity: %s", hexAmount)
 	}
-	return baseAmount / math.Pow10(decimals)
+	return util.ScaleBigAmount(new(big.Float).SetInt(amount), decimals), nil
 }
 
 func (l *Logger) Info(msg string, args ...interface{}) {
-	// Simple logging - can be enhanced later
+	if l.slog != nil {
+		l.slog.Info(msg, args...)
+	}
 }
 
 func (l *Logger) Error(msg string, args ...interface{}) {
-	// Simple logging - can be enhanced later
+	if l.slog != nil {
+		l.slog.Error(msg, args...)
+	}
 }
 
 func (l *Logger) Warn(msg string, args ...interface{}) {
-	// Simple logging - can be enhanced later
+	if l.slog != nil {
+		l.slog.Warn(msg, args...)
+	}
 }
 
 func (l *Logger) Debug(msg string, args ...interface{}) {
-	// Simple logging - can be enhanced later
+	if l.slog != nil {
+		l.slog.Debug(msg, args...)
+	}
 }
 
 // UnifiedCollector collects metrics from both Cosmos SDK and Ethereum
@@ -53,16 +71,71 @@ type UnifiedCollector struct {
 	client              *rpc.Client
 	cfg                 *config.Chain
 	ethereumConfig      *config.Ethereum
-	prometheusServer    string
+	prometheusClient    *util.PrometheusClient
+	endpointHeights     endpointHeights // cosmos_endpoint_height 짧은 캐시
+	ethHTTPClient       *http.Client // ethereum.tls 설정 시 사용 (nil = 기본 client)
 	logger              *Logger
 	blocksBehind        float64
 	blockTimeCalculator *util.BlockTimeCalculator
+	validatorStatesMu   sync.RWMutex
 	validatorStates     map[string]*validatorState
+	blockTracker        *blockTracker
+	limiter             ConcurrencyLimiter
+	exemplars           bool
+
+	mu                  sync.Mutex
+	lastCollectErr      error
+	lastSuccess         time.Time
+	collected           bool
+	successWindow       *successWindow // zerog_collection_success_ratio용 최근 수집 결과
+	collectionTimeout   time.Duration  // 수집 1회의 deadline (collection_timeout)
+	scrapeID            string
+	cachedMetrics       []prometheus.Metric
+	denomMetadata       map[string]denomInfo
+	bondDenom           string
+	sdkVersion          string
+	mintAbsentOnce      sync.Once
+	collectionErrors    map[string]float64
+	subsystemLastSuccess map[string]time.Time
+	scrapeTimeouts      float64
+	lastSigningRatio    float64 // 빈 last commit 블록에서 이어 쓰는 이전 block signing ratio
+	hasSigningRatio     bool
+	lastCountedHeight   int64
+	lastSlashScanHeight int64
+	lowestRetainedHeight int64
+	prunedWarnOnce      sync.Once
+	unmatchedWarnOnce   sync.Once
+	truncatedWarnOnce   sync.Once
+	validatorHex        map[string]string // 설정된 validator 주소 -> canonical hex (서명 / signing info 비교용)
+	slashEventsTotal    map[slashEventKey]float64
+	signedBlocksTotal   map[string]float64
+	missedBlocksTotal   map[string]float64
+	proposedBlocksTotal map[string]float64
+	scanWindow          int64 // 서명 / proposer 통계용 최근 블록 수 (block_tracking.scan_window)
+	avgBlockTimeGauge   bool  // cosmos_avg_block_time emit 여부 (block_tracking.avg_block_time_gauge)
 
 	// General Metrics
 	cosmosBlockTime     *prometheus.Desc
 	cosmosAvgBlockTime  *prometheus.Desc
+	cosmosBlockTimeEMA  *prometheus.Desc
 	cosmosTimeSinceLastBlock *prometheus.Desc
+	nodeInfo            *prometheus.Desc
+	nodeStatusInfo      *prometheus.Desc
+	chainInfo           *prometheus.Desc
+	chainLastError      *prometheus.Desc
+	networkAvgCommission    *prometheus.Desc
+	networkMedianCommission *prometheus.Desc
+	collectionSuccessRatio *prometheus.Desc
+	endpointHeight       *prometheus.Desc
+	endpointHeightSpread *prometheus.Desc
+	validatorUnbondingTotal *prometheus.Desc
+	unbondingTotal          *prometheus.Desc
+	denomInfoDesc       *prometheus.Desc
+	endpointCircuitOpen *prometheus.Desc
+	chainIDMatch        *prometheus.Desc
+	apiVersionUsed      *prometheus.Desc
+	blockTimeHistogram  prometheus.Histogram
+	collectionDuration  prometheus.Histogram
 
 	// Supply & Pool Metrics
 	bondedTokens        *prometheus.Desc
@@ -71,44 +144,100 @@ type UnifiedCollector struct {
 	supplyTotal         *prometheus.Desc
 	inflation           *prometheus.Desc
 	annualProvisions    *prometheus.Desc
+	bondedSupplyRatio   *prometheus.Desc
+	provisionsPerBlock  *prometheus.Desc
+	blocksPerYearEstimate *prometheus.Desc
 
 	// Wallet Metrics
 	walletBalance       *prometheus.Desc
 	walletDelegations   *prometheus.Desc
 	walletRewards       *prometheus.Desc
+	walletRewardsByValidator *prometheus.Desc
 	walletUnbonding     *prometheus.Desc
+	walletUnbondingCompletion *prometheus.Desc
+	walletTotal         *prometheus.Desc
 
 	// Validator Metrics
 	validatorTokens     *prometheus.Desc
 	validatorCommissionRate *prometheus.Desc
+	validatorCommissionMaxRate       *prometheus.Desc
+	validatorCommissionMaxChangeRate *prometheus.Desc
+	validatorCommissionUpdateTime    *prometheus.Desc
 	validatorCommission *prometheus.Desc
 	validatorRewards    *prometheus.Desc
+	validatorSelfDelegationRewards *prometheus.Desc
 	validatorMissedBlocks *prometheus.Desc
 	validatorRank       *prometheus.Desc
 	validatorActive     *prometheus.Desc
+	validatorInActiveSet *prometheus.Desc
+	validatorBondedSince *prometheus.Desc
+	validatorTokensChange *prometheus.Desc
+	validatorStakeShare   *prometheus.Desc
+	validatorHealth     *prometheus.Desc
+	validatorHealthComponent *prometheus.Desc
 	validatorStatus     *prometheus.Desc
 	validatorJailedDesc *prometheus.Desc
 	validatorDelegatorShares *prometheus.Desc
+	validatorVotingPower *prometheus.Desc
+	validatorVotingPowerPercent *prometheus.Desc
+	validatorUptimeRatio *prometheus.Desc
+	validatorBlocksUntilJail *prometheus.Desc
+	validatorTombstoned *prometheus.Desc
+	validatorJailedUntil *prometheus.Desc
+	validatorSigningStartHeight *prometheus.Desc
+	validatorSignedBlocksTotal *prometheus.Desc
+	validatorBlocksProposedTotal *prometheus.Desc
+	validatorExpectedProposals *prometheus.Desc
+	validatorActualProposals   *prometheus.Desc
+	validatorProposalRatio     *prometheus.Desc
+	validatorMissedBlocksTotal *prometheus.Desc
+	validatorConsecutiveMissed *prometheus.Desc
+	validatorJailThreshold *prometheus.Desc
+	validatorJailRisk      *prometheus.Desc
+	validatorMissAlert  *prometheus.Desc
+	wsConnected         *prometheus.Desc
+	wsReconnectsTotal   *prometheus.Desc
 
 	// Validator Statistics
 	validatorsTotal     *prometheus.Desc
+	validatorsTruncated *prometheus.Desc
 	validatorsActive    *prometheus.Desc
 	validatorsInactive  *prometheus.Desc
 	validatorsBondedRatio *prometheus.Desc
+	blockSigningRatio   *prometheus.Desc
 
 	// Chain Parameters
 	paramsSignedBlocksWindow *prometheus.Desc
 	paramsMinSignedPerWindow *prometheus.Desc
 	paramsDowntimeJailDuration *prometheus.Desc
+	paramsDowntimeJailBlocks *prometheus.Desc
 	paramsSlashFractionDoubleSign *prometheus.Desc
 	paramsSlashFractionDowntime *prometheus.Desc
 	paramsMaxValidators *prometheus.Desc
 	paramsBaseProposerReward *prometheus.Desc
 	paramsBonusProposerReward *prometheus.Desc
+	paramsCommunityTax *prometheus.Desc
+	paramsWithdrawAddrEnabled *prometheus.Desc
+
+	// IBC Metrics
+	ibcClientExpiry     *prometheus.Desc
+	ibcClientStatus     *prometheus.Desc
+	ibcEscrowBalance    *prometheus.Desc
+	blockScanWindowActual *prometheus.Desc
+
+	// Consensus State Metrics
+	consensusRound             *prometheus.Desc
+	consensusStep              *prometheus.Desc
+	consensusPrevotePowerRatio *prometheus.Desc
+	slashEventsTotalDesc       *prometheus.Desc
 
 	// Governance Metrics
-	consensusProposalChain *prometheus.Desc
 	consensusProposalReceiveCount *prometheus.Desc
+	govProposalTurnoutRatio *prometheus.Desc
+	govProposalQuorumReached *prometheus.Desc
+	govProposalTotalDeposit *prometheus.Desc
+	govProposalDepositEnd   *prometheus.Desc
+	govMinDeposit           *prometheus.Desc
 
 	// Tenderduty Metrics
 	tdUp                *prometheus.Desc
@@ -121,6 +250,17 @@ type UnifiedCollector struct {
 	tdValidatorJailed   *prometheus.Desc
 	tdTimeSinceLastBlock *prometheus.Desc
 
+	// Exporter Metrics
+	rpcCacheHits        *prometheus.Desc
+	rpcCacheMisses      *prometheus.Desc
+	collectionErrorsTotal *prometheus.Desc
+	configuredValidators  *prometheus.Desc
+	metricsAge            *prometheus.Desc
+	prometheusBackrefUp   *prometheus.Desc
+	configuredValidatorsMatched *prometheus.Desc
+	subsystemLastSuccessTimestamp *prometheus.Desc
+	scrapeTimeoutTotal  *prometheus.Desc
+
 	// Ethereum Metrics
 	ethBlockNumber      *prometheus.Desc
 	ethValidatorBalance *prometheus.Desc
@@ -130,6 +270,91 @@ type UnifiedCollector struct {
 	ethStakingPool      *prometheus.Desc
 	ethMaxValidators    *prometheus.Desc
 	ethValidatorCount   *prometheus.Desc
+	ethValidatorRegistered *prometheus.Desc
+	ethValidatorStatus  *prometheus.Desc
+}
+
+// maxConcurrentScanBlocks bounds the number of blocks fetched at the same time during the block scan
+const maxConcurrentScanBlocks = 8
+
+// scannedBlock is the result of fetching one block of the scan window
+type scannedBlock struct {
+	block *rpc.BlockResponse
+	err   error
+}
+
+// fetchScanWindow fetches the scanWindow most recent blocks (at most latestHeight), newest first, with at most
+// maxConcurrentScanBlocks requests in flight
+func fetchScanWindow(client *rpc.Client, latestHeight, scanWindow int64) []scannedBlock {
+	if scanWindow > latestHeight {
+		scanWindow = latestHeight
+	}
+	blocks := make([]scannedBlock, scanWindow)
+
+	var g errgroup.Group
+	g.SetLimit(maxConcurrentScanBlocks)
+	for i := range blocks {
+		i := i
+		g.Go(func() error {
+			block, err := client.GetBlock(int(latestHeight - int64(i)))
+			blocks[i] = scannedBlock{block: block, err: err}
+			return nil
+		})
+	}
+	g.Wait()
+	return blocks
+}
+
+// blockObservation holds the configured validators that signed, missed and proposed one scanned block
+type blockObservation struct {
+	signed   []string
+	missed   []string
+	proposed []string
+}
+
+// validatorBlockStats holds signing statistics for a validator over the scanned block window
+type validatorBlockStats struct {
+	signedBlocks         int
+	missedBlocks         int
+	consecutiveMissed    int
+	maxConsecutiveMissed int
+	trailingMissed       int // 가장 최근 블록부터 이어지는 연속 miss (현재 streak)
+	trailingEnded        bool
+	proposals            int
+}
+
+// recordSignature adds one scanned block to the stats. Blocks are scanned from the newest height down,
+// so the miss streak seen before the first signed block is the current (trailing) streak
+func (s *validatorBlockStats) recordSignature(signed bool) {
+	if signed {
+		s.signedBlocks++
+		s.consecutiveMissed = 0
+		s.trailingEnded = true
+		return
+	}
+	s.missedBlocks++
+	s.consecutiveMissed++
+	if s.consecutiveMissed > s.maxConsecutiveMissed {
+		s.maxConsecutiveMissed = s.consecutiveMissed
+	}
+	if !s.trailingEnded {
+		s.trailingMissed++
+	}
+}
+
+// validatorInfo holds the staking module fields used for per-validator metrics
+type validatorInfo struct {
+	OperatorAddress  string
+	Moniker          string
+	Tokens           string
+	DelegatorShares  string
+	CommissionRate   string
+	CommissionMaxRate       string
+	CommissionMaxChangeRate string
+	CommissionUpdateTime    string
+	Status           string
+	Jailed           bool
+	ConsensusAddress string
 }
 
 type validatorState struct {
@@ -138,23 +363,99 @@ type validatorState struct {
 	status           int
 	active           bool
 	jailed           bool
+	bondedSince      time.Time
+	previousTokens   float64
+	hasPrevTokens    bool
+	signedLatest     bool // 마지막으로 확인한 (last commit이 비어 있지 않은) 최신 블록 서명 여부
 }
 
 // NewUnifiedCollector creates a new UnifiedCollector
-func NewUnifiedCollector(client *rpc.Client, cfg *config.Chain, ethereumConfig *config.Ethereum, prometheusServer string) *UnifiedCollector {
+func NewUnifiedCollector(client *rpc.Client, cfg *config.Chain, ethereumConfig *config.Ethereum, blockTracking *config.BlockTracking, prometheusServer string) *UnifiedCollector {
+	logger := &Logger{}
+
+	var tracker *blockTracker
+	if blockTracking != nil && blockTracking.Enabled {
+		tracker = newBlockTracker(client, cfg.Validators, cfg.WebSocket, blockTracking, logger)
+	}
+
+	blockTimeBuckets := defaultBlockTimeBuckets
+	if blockTracking != nil && len(blockTracking.BlockTimeBuckets) > 0 {
+		blockTimeBuckets = blockTracking.BlockTimeBuckets
+	}
+
+	blockTimeCalculator := util.NewBlockTimeCalculator(100)
+	if blockTracking != nil && blockTracking.BlockTimeMaxAge > 0 {
+		blockTimeCalculator.SetMaxAge(time.Duration(blockTracking.BlockTimeMaxAge) * time.Second)
+	}
+	if blockTracking != nil {
+		blockTimeCalculator.SetEMAAlpha(blockTracking.BlockTimeEMAAlpha)
+	}
+
+	scanWindow := int64(config.DefaultBlockScanWindow)
+	if blockTracking != nil && blockTracking.ScanWindow > 0 {
+		scanWindow = int64(blockTracking.ScanWindow)
+	}
+	avgBlockTimeGauge := blockTracking == nil || blockTracking.AvgBlockTimeGauge == nil || *blockTracking.AvgBlockTimeGauge
+
+	// prometheus.server가 비어 있으면 backref 기능 비활성화
+	var prometheusClient *util.PrometheusClient
+	if prometheusServer != "" {
+		prometheusClient = util.NewPrometheusClient(prometheusServer)
+	}
+
 	return &UnifiedCollector{
 		client:              client,
 		cfg:                 cfg,
 		ethereumConfig:      ethereumConfig,
-		prometheusServer:    prometheusServer,
-		logger:              &Logger{},
-		blockTimeCalculator: util.NewBlockTimeCalculator(100),
+		prometheusClient:    prometheusClient,
+		logger:              logger,
+		blockTimeCalculator: blockTimeCalculator,
 		validatorStates:     make(map[string]*validatorState),
+		validatorHex:        consensusHexMap(cfg.Validators),
+		blockTracker:        tracker,
+		collectionErrors:    make(map[string]float64),
+		subsystemLastSuccess: make(map[string]time.Time),
+		successWindow:       newSuccessWindow(config.DefaultSuccessRatioWindow),
+		collectionTimeout:   config.DefaultCollectionTimeout * time.Second,
+		signedBlocksTotal:   make(map[string]float64),
+		missedBlocksTotal:   make(map[string]float64),
+		proposedBlocksTotal: make(map[string]float64),
+		scanWindow:          scanWindow,
+		avgBlockTimeGauge:   avgBlockTimeGauge,
+		slashEventsTotal:    make(map[slashEventKey]float64),
 
 		// General Metrics
 		cosmosBlockTime: prometheus.NewDesc("cosmos_block_time", "Last block time", []string{"chain_id"}, nil),
 		cosmosAvgBlockTime: prometheus.NewDesc("cosmos_avg_block_time", "Average block time", []string{"chain_id"}, nil),
+		cosmosBlockTimeEMA: prometheus.NewDesc("cosmos_block_time_ema", "Exponential moving average block time in seconds (block_time_ema_alpha weights the newest sample)", []string{"chain_id"}, nil),
 		cosmosTimeSinceLastBlock: prometheus.NewDesc("cosmos_time_since_last_block", "Time since last block", []string{"chain_id"}, nil),
+		blockTimeHistogram: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:        "cosmos_block_time_seconds",
+			Help:        "Block production time (header time difference to the previous block)",
+			Buckets:     blockTimeBuckets,
+			ConstLabels: prometheus.Labels{"chain_id": cfg.ChainID},
+		}),
+		collectionDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:        "zerog_collection_duration_seconds",
+			Help:        "Duration of a full metrics collection of the chain",
+			Buckets:     prometheus.DefBuckets,
+			ConstLabels: prometheus.Labels{"chain_id": cfg.ChainID},
+		}),
+		nodeInfo: prometheus.NewDesc("cosmos_node_info", "Node application info (always 1)", []string{"chain_id", "app_name", "app_version", "git_commit", "cosmos_sdk_version", "network"}, nil),
+		denomInfoDesc: prometheus.NewDesc("cosmos_denom_info", "Base denom behind each display denom label (always 1)", []string{"chain_id", "denom", "base_denom"}, nil),
+		validatorUnbondingTotal: prometheus.NewDesc("cosmos_validator_unbonding_total", "Sum of the unbonding delegation entries of a validator (unbonding_scope selects the validators)", []string{"chain_id", "address", "moniker", "denom"}, nil),
+		unbondingTotal: prometheus.NewDesc("cosmos_unbonding_total", "Sum of the unbonding delegation entries of all validators (only with unbonding_scope: all)", []string{"chain_id", "denom"}, nil),
+		endpointHeight: prometheus.NewDesc("cosmos_endpoint_height", "Latest block height reported by each configured RPC endpoint (rpc, archive_rpc, extra_rpcs)", []string{"chain_id", "endpoint"}, nil),
+		endpointHeightSpread: prometheus.NewDesc("cosmos_endpoint_height_spread", "Difference between the highest and lowest height reported by the configured RPC endpoints (a large spread means a lagging or forked node)", []string{"chain_id"}, nil),
+		collectionSuccessRatio: prometheus.NewDesc("zerog_collection_success_ratio", "Share of successful collections over the last success_ratio_window collections (0-1)", []string{"chain_id"}, nil),
+		networkAvgCommission: prometheus.NewDesc("cosmos_network_avg_commission", "Commission rate of all validators weighted by their tokens: sum(rate * tokens) / sum(tokens)", []string{"chain_id"}, nil),
+		networkMedianCommission: prometheus.NewDesc("cosmos_network_median_commission", "Median commission rate of all validators", []string{"chain_id"}, nil),
+		chainLastError: prometheus.NewDesc("zerog_chain_last_error", "Reason of the last collection failure, normalized to a small set such as timeout, connection_refused or http_404 (\"none\" after a successful collection, always 1)", []string{"chain_id", "error"}, nil),
+		chainInfo: prometheus.NewDesc("cosmos_chain_info", "Configured chain name and the network reported by the node (always 1, join on chain_id for friendly names)", []string{"chain_id", "name", "network"}, nil),
+		nodeStatusInfo: prometheus.NewDesc("cosmos_node_status_info", "Identity of the node that served /status (always 1)", []string{"chain_id", "node_id", "moniker", "cometbft_version"}, nil),
+		endpointCircuitOpen: prometheus.NewDesc("cosmos_endpoint_circuit_open", "1 while the circuit breaker of the endpoint is open and requests fast-fail", []string{"chain_id", "endpoint"}, nil),
+		chainIDMatch: prometheus.NewDesc("cosmos_chain_id_match", "1 if the configured chain_id equals the network reported by the node", []string{"chain_id", "detected_chain_id"}, nil),
+		apiVersionUsed: prometheus.NewDesc("cosmos_api_version_used", "REST API version the node answered for each module (value is always 1)", []string{"chain_id", "module", "version"}, nil),
 
 		// Supply & Pool Metrics
 		bondedTokens: prometheus.NewDesc("cosmos_bonded_tokens", "Bonded tokens", []string{"chain_id", "denom"}, nil),
@@ -163,44 +464,100 @@ func NewUnifiedCollector(client *rpc.Client, cfg *config.Chain, ethereumConfig *
 		supplyTotal: prometheus.NewDesc("cosmos_supply_total", "Total supply", []string{"chain_id", "denom"}, nil),
 		inflation: prometheus.NewDesc("cosmos_inflation", "Inflation rate", []string{"chain_id"}, nil),
 		annualProvisions: prometheus.NewDesc("cosmos_annual_provisions", "Annual provisions", []string{"chain_id", "denom"}, nil),
+		provisionsPerBlock: prometheus.NewDesc("cosmos_provisions_per_block", "Annual provisions divided by the estimated blocks per year (assumes the current average block time holds for a 365.25-day year; NaN until block time is measured)", []string{"chain_id", "denom"}, nil),
+		blocksPerYearEstimate: prometheus.NewDesc("cosmos_blocks_per_year_estimate", "Estimated blocks per year: seconds in a 365.25-day year divided by the current average block time (NaN until block time is measured)", []string{"chain_id"}, nil),
+		bondedSupplyRatio: prometheus.NewDesc("cosmos_bonded_supply_ratio", "Bonded tokens divided by the total supply of the staking denom", []string{"chain_id"}, nil),
 
 		// Wallet Metrics
-		walletBalance: prometheus.NewDesc("cosmos_wallet_balance", "Wallet balance", []string{"chain_id", "address", "denom"}, nil),
-		walletDelegations: prometheus.NewDesc("cosmos_wallet_delegations", "Wallet delegations", []string{"chain_id", "address", "denom"}, nil),
-		walletRewards: prometheus.NewDesc("cosmos_wallet_rewards", "Wallet rewards", []string{"chain_id", "address", "denom"}, nil),
-		walletUnbonding: prometheus.NewDesc("cosmos_wallet_unbonding", "Wallet unbonding", []string{"chain_id", "address", "denom"}, nil),
+		walletBalance: prometheus.NewDesc("cosmos_wallet_balance", "Wallet balance", []string{"chain_id", "address", "name", "denom"}, nil),
+		walletDelegations: prometheus.NewDesc("cosmos_wallet_delegations", "Wallet delegations", []string{"chain_id", "address", "name", "denom"}, nil),
+		walletRewards: prometheus.NewDesc("cosmos_wallet_rewards", "Wallet rewards", []string{"chain_id", "address", "name", "denom"}, nil),
+		walletRewardsByValidator: prometheus.NewDesc("cosmos_wallet_rewards_by_validator", "Wallet rewards accrued from each validator the wallet delegates to", []string{"chain_id", "address", "name", "validator", "denom"}, nil),
+		walletUnbonding: prometheus.NewDesc("cosmos_wallet_unbonding", "Wallet unbonding", []string{"chain_id", "address", "name", "denom"}, nil),
+		walletUnbondingCompletion: prometheus.NewDesc("cosmos_wallet_unbonding_completion_seconds", "Seconds until the earliest unbonding entry from the validator completes (0 once completed)", []string{"chain_id", "address", "name", "validator"}, nil),
+		walletTotal: prometheus.NewDesc("cosmos_wallet_total", "Wallet total of balance, delegations, rewards and unbonding in the staking denom (display unit)", []string{"chain_id", "address", "name"}, nil),
 
 		// Validator Metrics
 		validatorTokens: prometheus.NewDesc("cosmos_validator_tokens", "Validator tokens", []string{"chain_id", "address", "moniker", "denom"}, nil),
+		validatorStakeShare: prometheus.NewDesc("cosmos_validator_stake_share", "Validator tokens as a share of the network bonded tokens (0-1, NaN when nothing is bonded)", []string{"chain_id", "address", "moniker"}, nil),
 		validatorCommissionRate: prometheus.NewDesc("cosmos_validator_commission_rate", "Validator commission rate", []string{"chain_id", "address", "moniker"}, nil),
+		validatorCommissionMaxRate: prometheus.NewDesc("cosmos_validator_commission_max_rate", "Maximum commission rate the validator can ever charge", []string{"chain_id", "address", "moniker"}, nil),
+		validatorCommissionMaxChangeRate: prometheus.NewDesc("cosmos_validator_commission_max_change_rate", "Maximum daily increase of the validator commission rate", []string{"chain_id", "address", "moniker"}, nil),
+		validatorCommissionUpdateTime: prometheus.NewDesc("cosmos_validator_commission_update_time", "Unix timestamp of the last commission rate change", []string{"chain_id", "address", "moniker"}, nil),
 		validatorCommission: prometheus.NewDesc("cosmos_validator_commission", "Validator commission", []string{"chain_id", "address", "moniker", "denom"}, nil),
 		validatorRewards: prometheus.NewDesc("cosmos_validator_rewards", "Validator rewards", []string{"chain_id", "address", "moniker", "denom"}, nil),
-		validatorMissedBlocks: prometheus.NewDesc("cosmos_validator_missed_blocks", "Validator missed blocks", []string{"chain_id", "address", "moniker"}, nil),
+		validatorSelfDelegationRewards: prometheus.NewDesc("cosmos_validator_self_delegation_rewards", "Pending rewards of the validator operator's self-delegation", []string{"chain_id", "address", "moniker", "denom"}, nil),
+		validatorMissedBlocks: prometheus.NewDesc("cosmos_validator_missed_blocks", "Validator missed blocks in the slashing window (signing info missed_blocks_counter, scan window count as fallback)", []string{"chain_id", "address", "moniker"}, nil),
 		validatorRank: prometheus.NewDesc("cosmos_validators_rank", "Validator rank", []string{"chain_id", "address", "moniker"}, nil),
 		validatorActive: prometheus.NewDesc("cosmos_validator_active", "Validator active status", []string{"chain_id", "address", "moniker"}, nil),
+		validatorHealth: prometheus.NewDesc("cosmos_validator_health", "Composite validator health score (0-1) from the weighted health components", []string{"chain_id", "address", "moniker"}, nil),
+		validatorHealthComponent: prometheus.NewDesc("cosmos_validator_health_component", "Validator health component status (1 = passing): active, signing_ratio, consecutive_missed, not_tombstoned", []string{"chain_id", "address", "moniker", "component"}, nil),
+		validatorTokensChange: prometheus.NewDesc("cosmos_validator_tokens_change", "Change of validator bonded tokens since the previous collection (negative = net undelegation)", []string{"chain_id", "address", "moniker", "denom"}, nil),
+		validatorBondedSince: prometheus.NewDesc("cosmos_validator_bonded_since_seconds", "Unix timestamp at which the validator was first observed bonded (exporter start if already bonded); absent while not bonded", []string{"chain_id", "address", "moniker"}, nil),
+		validatorInActiveSet: prometheus.NewDesc("cosmos_validator_in_active_set", "1 if the validator is in the consensus (active) validator set", []string{"chain_id", "address", "moniker"}, nil),
 		validatorStatus: prometheus.NewDesc("cosmos_validator_status", "Validator status", []string{"chain_id", "address", "moniker"}, nil),
 		validatorJailedDesc: prometheus.NewDesc("cosmos_validator_jailed_status", "Validator jailed status", []string{"chain_id", "address", "moniker"}, nil),
 		validatorDelegatorShares: prometheus.NewDesc("cosmos_validators_delegator_shares", "Validator delegator shares", []string{"chain_id", "address", "moniker"}, nil),
+		validatorVotingPower: prometheus.NewDesc("cosmos_validator_voting_power", "Validator consensus voting power", []string{"chain_id", "address", "moniker"}, nil),
+		validatorVotingPowerPercent: prometheus.NewDesc("cosmos_validator_voting_power_percent", "Validator share of total consensus voting power in percent", []string{"chain_id", "address", "moniker"}, nil),
+		validatorUptimeRatio: prometheus.NewDesc("cosmos_validator_uptime_ratio", "Validator uptime over the slashing window: (signed_blocks_window - missed_blocks_counter) / signed_blocks_window", []string{"chain_id", "address", "moniker"}, nil),
+		validatorBlocksUntilJail: prometheus.NewDesc("cosmos_validator_blocks_until_jail", "Blocks the validator can still miss in the slashing window before being jailed", []string{"chain_id", "address", "moniker"}, nil),
+		validatorTombstoned: prometheus.NewDesc("cosmos_validator_tombstoned", "Validator tombstoned status (1 = tombstoned)", []string{"chain_id", "address", "moniker"}, nil),
+		validatorJailedUntil: prometheus.NewDesc("cosmos_validator_jailed_until_seconds", "Unix timestamp until which the validator is jailed", []string{"chain_id", "address", "moniker"}, nil),
+		validatorSigningStartHeight: prometheus.NewDesc("cosmos_validator_signing_start_height", "Height at which the validator's current signing info started", []string{"chain_id", "address", "moniker"}, nil),
+		validatorBlocksProposedTotal: prometheus.NewDesc("cosmos_validator_blocks_proposed_total", "Cumulative blocks proposed by the validator observed since exporter start", []string{"chain_id", "address", "moniker"}, nil),
+		validatorExpectedProposals: prometheus.NewDesc("cosmos_validator_expected_proposals", "Blocks the validator is expected to propose over the block scan window from its share of consensus voting power", []string{"chain_id", "address", "moniker"}, nil),
+		validatorActualProposals: prometheus.NewDesc("cosmos_validator_actual_proposals", "Blocks proposed by the validator over the block scan window", []string{"chain_id", "address", "moniker"}, nil),
+		validatorProposalRatio: prometheus.NewDesc("cosmos_validator_proposal_ratio", "Actual / expected proposals over the block scan window (persistently low for a high-power validator hints at a consensus problem)", []string{"chain_id", "address", "moniker"}, nil),
+		validatorSignedBlocksTotal: prometheus.NewDesc("cosmos_validator_signed_blocks_total", "Cumulative signed blocks observed since exporter start", []string{"chain_id", "address"}, nil),
+		validatorMissedBlocksTotal: prometheus.NewDesc("cosmos_validator_missed_blocks_total", "Cumulative missed blocks observed since exporter start", []string{"chain_id", "address"}, nil),
+		validatorJailThreshold: prometheus.NewDesc("cosmos_validator_downtime_jail_threshold_blocks", "Missed blocks within the signing window that get the validator jailed (signed_blocks_window * (1 - min_signed_per_window))", []string{"chain_id", "address", "moniker"}, nil),
+		validatorJailRisk: prometheus.NewDesc("cosmos_validator_jail_risk", "Current consecutive missed blocks as a share of the downtime jail threshold (1 = jailed if the window was clean)", []string{"chain_id", "address", "moniker"}, nil),
+		validatorConsecutiveMissed: prometheus.NewDesc("cosmos_validator_consecutive_missed", "Live consecutive missed blocks tracked by the background block tracker", []string{"chain_id", "address"}, nil),
+		validatorMissAlert: prometheus.NewDesc("cosmos_validator_miss_alert", "1 if consecutive missed blocks reached block_tracking.max_consecutive_missed", []string{"chain_id", "address"}, nil),
+		wsConnected: prometheus.NewDesc("cosmos_ws_connected", "1 if the block tracker websocket subscription is connected", []string{"chain_id"}, nil),
+		wsReconnectsTotal: prometheus.NewDesc("cosmos_ws_reconnects_total", "Block tracker websocket reconnect attempts", []string{"chain_id"}, nil),
 
 		// Validator Statistics
-		validatorsTotal: prometheus.NewDesc("cosmos_validators_total", "Total validators", []string{"chain_id"}, nil),
-		validatorsActive: prometheus.NewDesc("cosmos_validators_active", "Active validators", []string{"chain_id"}, nil),
-		validatorsInactive: prometheus.NewDesc("cosmos_validators_inactive", "Inactive validators", []string{"chain_id"}, nil),
-		validatorsBondedRatio: prometheus.NewDesc("cosmos_validators_bonded_ratio", "Bonded ratio", []string{"chain_id"}, nil),
+		validatorsTruncated: prometheus.NewDesc("cosmos_validators_truncated", "1 if max_validators cut the staking validator list short (network-wide validator metrics are then not emitted)", []string{"chain_id"}, nil),
+		validatorsTotal: prometheus.NewDesc("cosmos_validators_total", "Total validators in the staking set", []string{"chain_id"}, nil),
+		validatorsActive: prometheus.NewDesc("cosmos_validators_active", "Active validators (status BOND_STATUS_BONDED)", []string{"chain_id"}, nil),
+		validatorsInactive: prometheus.NewDesc("cosmos_validators_inactive", "Inactive validators (status other than BOND_STATUS_BONDED)", []string{"chain_id"}, nil),
+		validatorsBondedRatio: prometheus.NewDesc("cosmos_validators_bonded_ratio", "Deprecated alias of cosmos_bonded_supply_ratio (same value: bonded tokens divided by the total supply of the staking denom), kept for existing dashboards and alerts", []string{"chain_id"}, nil),
+		blockSigningRatio: prometheus.NewDesc("cosmos_block_signing_ratio", "Share of validators in the latest block's last commit that signed it (commit signatures / total signatures)", []string{"chain_id"}, nil),
 
 		// Chain Parameters
 		paramsSignedBlocksWindow: prometheus.NewDesc("cosmos_params_signed_blocks_window", "Signed blocks window", []string{"chain_id"}, nil),
 		paramsMinSignedPerWindow: prometheus.NewDesc("cosmos_params_min_signed_per_window", "Min signed per window", []string{"chain_id"}, nil),
-		paramsDowntimeJailDuration: prometheus.NewDesc("cosmos_params_downtime_jail_duration", "Downtime jail duration", []string{"chain_id"}, nil),
+		paramsDowntimeJailDuration: prometheus.NewDesc("cosmos_params_downtime_jail_duration", "Downtime jail duration in seconds", []string{"chain_id"}, nil),
+		paramsDowntimeJailBlocks: prometheus.NewDesc("cosmos_params_downtime_jail_blocks", "Downtime jail duration in blocks at the measured average block time", []string{"chain_id"}, nil),
 		paramsSlashFractionDoubleSign: prometheus.NewDesc("cosmos_params_slash_fraction_double_sign", "Slash fraction double sign", []string{"chain_id"}, nil),
 		paramsSlashFractionDowntime: prometheus.NewDesc("cosmos_params_slash_fraction_downtime", "Slash fraction downtime", []string{"chain_id"}, nil),
 		paramsMaxValidators: prometheus.NewDesc("cosmos_params_max_validators", "Max validators", []string{"chain_id"}, nil),
 		paramsBaseProposerReward: prometheus.NewDesc("cosmos_params_base_proposer_reward", "Base proposer reward", []string{"chain_id"}, nil),
 		paramsBonusProposerReward: prometheus.NewDesc("cosmos_params_bonus_proposer_reward", "Bonus proposer reward", []string{"chain_id"}, nil),
+		paramsCommunityTax: prometheus.NewDesc("cosmos_params_community_tax", "Community tax", []string{"chain_id"}, nil),
+		paramsWithdrawAddrEnabled: prometheus.NewDesc("cosmos_params_withdraw_addr_enabled", "Withdraw address enabled (1 = enabled, 0 = disabled)", []string{"chain_id"}, nil),
+
+		// IBC Metrics
+		ibcClientExpiry: prometheus.NewDesc("cosmos_ibc_client_expiry_seconds", "Seconds until the IBC client expires (latest consensus state time + trusting period - now)", []string{"chain_id", "client_id", "counterparty_chain_id"}, nil),
+		ibcClientStatus: prometheus.NewDesc("cosmos_ibc_client_status", "IBC client status (1 = active, 2 = expired, 3 = frozen)", []string{"chain_id", "client_id", "counterparty_chain_id"}, nil),
+		ibcEscrowBalance: prometheus.NewDesc("cosmos_ibc_escrow_balance", "Balance of an IBC transfer channel escrow account", []string{"chain_id", "port", "channel", "denom"}, nil),
+		blockScanWindowActual: prometheus.NewDesc("cosmos_block_scan_window_actual", "Blocks actually scanned for signing stats (below block_tracking.scan_window when the node is pruned)", []string{"chain_id"}, nil),
+
+		// Consensus State Metrics
+		consensusRound: prometheus.NewDesc("cometbft_consensus_round", "Current consensus round at the height being decided", []string{"chain_id"}, nil),
+		consensusStep: prometheus.NewDesc("cometbft_consensus_step", "Current consensus round step (e.g. 4 = prevote, 6 = precommit)", []string{"chain_id"}, nil),
+		consensusPrevotePowerRatio: prometheus.NewDesc("cometbft_consensus_prevote_power_ratio", "Share of voting power that prevoted in the current round", []string{"chain_id"}, nil),
+		slashEventsTotalDesc: prometheus.NewDesc("cosmos_slash_events_total", "Slash and liveness (missed block) events observed in block results since exporter start", []string{"chain_id", "address", "reason"}, nil),
 
 		// Governance Metrics
-		consensusProposalChain: prometheus.NewDesc("cometbft_consensus_proposal_chain", "Consensus proposal chain", []string{"chain_id"}, nil),
 		consensusProposalReceiveCount: prometheus.NewDesc("cosmos_consensus_proposal_receive_count", "Consensus proposal receive count", []string{"chain_id", "status"}, nil),
+		govProposalTurnoutRatio: prometheus.NewDesc("cosmos_gov_proposal_turnout_ratio", "Voting power that has voted divided by bonded tokens, for proposals in voting period", []string{"chain_id", "proposal_id"}, nil),
+		govProposalQuorumReached: prometheus.NewDesc("cosmos_gov_proposal_quorum_reached", "1 if turnout has reached the tally quorum, for proposals in voting period", []string{"chain_id", "proposal_id"}, nil),
+		govProposalTotalDeposit: prometheus.NewDesc("cosmos_gov_proposal_total_deposit", "Total deposit of a proposal in deposit period", []string{"chain_id", "proposal_id", "denom"}, nil),
+		govProposalDepositEnd: prometheus.NewDesc("cosmos_gov_proposal_deposit_end_seconds", "Seconds until the deposit period of a proposal ends (0 once ended)", []string{"chain_id", "proposal_id"}, nil),
+		govMinDeposit: prometheus.NewDesc("cosmos_gov_min_deposit", "Minimum deposit for a proposal to enter voting period", []string{"chain_id", "denom"}, nil),
 
 		// Tenderduty Metrics
 		tdUp: prometheus.NewDesc("cosmos_td_up", "Tenderduty status", []string{"chain_id"}, nil),
@@ -213,15 +570,28 @@ func NewUnifiedCollector(client *rpc.Client, cfg *config.Chain, ethereumConfig *
 		tdValidatorJailed: prometheus.NewDesc("cosmos_td_validator_jailed", "Tenderduty validator jailed", []string{"chain_id"}, nil),
 		tdTimeSinceLastBlock: prometheus.NewDesc("cosmos_td_time_since_last_block", "Tenderduty time since last block", []string{"chain_id"}, nil),
 
+		// Exporter Metrics
+		rpcCacheHits: prometheus.NewDesc("zerog_rpc_cache_hits_total", "RPC response cache hits", []string{"chain_id"}, nil),
+		rpcCacheMisses: prometheus.NewDesc("zerog_rpc_cache_misses_total", "RPC response cache misses", []string{"chain_id"}, nil),
+		subsystemLastSuccessTimestamp: prometheus.NewDesc("zerog_subsystem_last_success_timestamp", "Unix timestamp of the last successful collection per subsystem", []string{"chain_id", "subsystem"}, nil),
+		scrapeTimeoutTotal: prometheus.NewDesc("zerog_scrape_timeout_total", "Collections that exceeded the collection deadline", []string{"chain_id"}, nil),
+		collectionErrorsTotal: prometheus.NewDesc("zerog_collection_errors_total", "Failed endpoint fetches during collection", []string{"chain_id", "endpoint"}, nil),
+		prometheusBackrefUp: prometheus.NewDesc("zerog_prometheus_backref_up", "1 if the configured Prometheus server answered the backref query (only emitted when prometheus.server is set)", []string{"chain_id"}, nil),
+		metricsAge: prometheus.NewDesc("zerog_metrics_age_seconds", "Seconds since the last successful collection backing the served metrics", []string{"chain_id"}, nil),
+		configuredValidators: prometheus.NewDesc("zerog_configured_validators", "Validator consensus addresses listed in config", []string{"chain_id"}, nil),
+		configuredValidatorsMatched: prometheus.NewDesc("zerog_configured_validators_matched", "Configured validators found in the staking validator set", []string{"chain_id"}, nil),
+
 		// Ethereum Metrics
 		ethBlockNumber: prometheus.NewDesc("eth_block_number", "Ethereum block number", []string{"chain_id"}, nil),
 		ethValidatorBalance: prometheus.NewDesc("eth_validator_balance", "Validator balance on Ethereum", []string{"chain_id", "address", "moniker"}, nil),
-		ethStakingContract: prometheus.NewDesc("eth_staking_contract", "Staking contract status", []string{"chain_id", "contract"}, nil),
-		ethTotalValidators: prometheus.NewDesc("eth_total_validators", "Total validators on contract", []string{"chain_id"}, nil),
-		ethActiveValidators: prometheus.NewDesc("eth_active_validators", "Active validators on contract", []string{"chain_id"}, nil),
-		ethStakingPool: prometheus.NewDesc("eth_staking_pool", "Staking pool balance", []string{"chain_id"}, nil),
-		ethMaxValidators: prometheus.NewDesc("eth_max_validators", "Maximum validators", []string{"chain_id"}, nil),
-		ethValidatorCount: prometheus.NewDesc("eth_validator_count", "Validator count", []string{"chain_id"}, nil),
+		ethStakingContract: prometheus.NewDesc("eth_staking_contract", "Contract reachability status (1 = balance query succeeded)", []string{"chain_id", "contract", "address"}, nil),
+		ethTotalValidators: prometheus.NewDesc("eth_total_validators", "Total validators on contract", []string{"chain_id", "contract"}, nil),
+		ethActiveValidators: prometheus.NewDesc("eth_active_validators", "Active validators on contract", []string{"chain_id", "contract"}, nil),
+		ethStakingPool: prometheus.NewDesc("eth_staking_pool", "Staking pool balance", []string{"chain_id", "contract"}, nil),
+		ethMaxValidators: prometheus.NewDesc("eth_max_validators", "Maximum validators", []string{"chain_id", "contract"}, nil),
+		ethValidatorCount: prometheus.NewDesc("eth_validator_count", "Validator count", []string{"chain_id", "contract"}, nil),
+		ethValidatorStatus: prometheus.NewDesc("eth_validator_status", "Configured validator registered on the staking contract (1 = registered, 0 = not registered)", []string{"chain_id", "name", "pubkey", "address"}, nil),
+		ethValidatorRegistered: prometheus.NewDesc("eth_validator_registered", "Validator registered on the staking contract (always 1, index = position in the contract's list)", []string{"chain_id", "contract", "address", "index"}, nil),
 	}
 }
 
@@ -229,47 +599,126 @@ func NewUnifiedCollector(client *rpc.Client, cfg *config.Chain, ethereumConfig *
 func (c *UnifiedCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.cosmosBlockTime
 	ch <- c.cosmosAvgBlockTime
+	ch <- c.cosmosBlockTimeEMA
 	ch <- c.cosmosTimeSinceLastBlock
+	ch <- c.nodeInfo
+	ch <- c.nodeStatusInfo
+	ch <- c.chainInfo
+	ch <- c.chainLastError
+	ch <- c.networkAvgCommission
+	ch <- c.networkMedianCommission
+	ch <- c.collectionSuccessRatio
+	ch <- c.endpointHeight
+	ch <- c.endpointHeightSpread
+	ch <- c.validatorUnbondingTotal
+	ch <- c.unbondingTotal
+	ch <- c.denomInfoDesc
+	ch <- c.endpointCircuitOpen
+	ch <- c.chainIDMatch
+	ch <- c.apiVersionUsed
+	c.blockTimeHistogram.Describe(ch)
+	c.collectionDuration.Describe(ch)
 	ch <- c.bondedTokens
 	ch <- c.notBondedTokens
 	ch <- c.communityPool
 	ch <- c.supplyTotal
 	ch <- c.inflation
 	ch <- c.annualProvisions
+	ch <- c.bondedSupplyRatio
+	ch <- c.provisionsPerBlock
+	ch <- c.blocksPerYearEstimate
 	ch <- c.walletBalance
 	ch <- c.walletDelegations
 	ch <- c.walletRewards
+	ch <- c.walletRewardsByValidator
 	ch <- c.walletUnbonding
+	ch <- c.walletUnbondingCompletion
+	ch <- c.walletTotal
 	ch <- c.validatorTokens
 	ch <- c.validatorCommissionRate
+	ch <- c.validatorCommissionMaxRate
+	ch <- c.validatorCommissionMaxChangeRate
+	ch <- c.validatorCommissionUpdateTime
 	ch <- c.validatorCommission
 	ch <- c.validatorRewards
+	ch <- c.validatorSelfDelegationRewards
 	ch <- c.validatorMissedBlocks
 	ch <- c.validatorRank
 	ch <- c.validatorActive
+	ch <- c.validatorInActiveSet
+	ch <- c.validatorBondedSince
+	ch <- c.validatorTokensChange
+	ch <- c.validatorStakeShare
+	ch <- c.validatorHealth
+	ch <- c.validatorHealthComponent
 	ch <- c.validatorStatus
 	ch <- c.validatorJailedDesc
 	ch <- c.validatorDelegatorShares
+	ch <- c.validatorVotingPower
+	ch <- c.validatorVotingPowerPercent
+	ch <- c.validatorUptimeRatio
+	ch <- c.validatorBlocksUntilJail
+	ch <- c.validatorTombstoned
+	ch <- c.validatorJailedUntil
+	ch <- c.validatorSigningStartHeight
+	ch <- c.validatorBlocksProposedTotal
+	ch <- c.validatorExpectedProposals
+	ch <- c.validatorActualProposals
+	ch <- c.validatorProposalRatio
+	ch <- c.validatorSignedBlocksTotal
+	ch <- c.validatorMissedBlocksTotal
+	ch <- c.validatorConsecutiveMissed
+	ch <- c.validatorJailThreshold
+	ch <- c.validatorJailRisk
+	ch <- c.validatorMissAlert
+	ch <- c.wsConnected
+	ch <- c.wsReconnectsTotal
 	ch <- c.validatorsTotal
+	ch <- c.validatorsTruncated
 	ch <- c.validatorsActive
 	ch <- c.validatorsInactive
 	ch <- c.validatorsBondedRatio
+	ch <- c.blockSigningRatio
 	ch <- c.paramsSignedBlocksWindow
 	ch <- c.paramsMinSignedPerWindow
 	ch <- c.paramsDowntimeJailDuration
+	ch <- c.paramsDowntimeJailBlocks
 	ch <- c.paramsSlashFractionDoubleSign
 	ch <- c.paramsSlashFractionDowntime
 	ch <- c.paramsMaxValidators
 	ch <- c.paramsBaseProposerReward
 	ch <- c.paramsBonusProposerReward
-	ch <- c.consensusProposalChain
+	ch <- c.paramsCommunityTax
+	ch <- c.paramsWithdrawAddrEnabled
+	ch <- c.ibcClientExpiry
+	ch <- c.ibcClientStatus
+	ch <- c.ibcEscrowBalance
+	ch <- c.blockScanWindowActual
+	ch <- c.consensusRound
+	ch <- c.consensusStep
+	ch <- c.consensusPrevotePowerRatio
+	ch <- c.slashEventsTotalDesc
 	ch <- c.consensusProposalReceiveCount
+	ch <- c.govProposalTurnoutRatio
+	ch <- c.govProposalQuorumReached
+	ch <- c.govProposalTotalDeposit
+	ch <- c.govProposalDepositEnd
+	ch <- c.govMinDeposit
 	ch <- c.tdSignedBlocks
 	ch <- c.tdMissedBlocks
 	ch <- c.tdConsecutiveMissed
 	ch <- c.tdValidatorActive
 	ch <- c.tdValidatorJailed
 	ch <- c.tdTimeSinceLastBlock
+	ch <- c.rpcCacheHits
+	ch <- c.rpcCacheMisses
+	ch <- c.collectionErrorsTotal
+	ch <- c.metricsAge
+	ch <- c.prometheusBackrefUp
+	ch <- c.configuredValidators
+	ch <- c.configuredValidatorsMatched
+	ch <- c.subsystemLastSuccessTimestamp
+	ch <- c.scrapeTimeoutTotal
 	ch <- c.ethBlockNumber
 	ch <- c.ethValidatorBalance
 	ch <- c.ethStakingContract
@@ -278,219 +727,658 @@ func (c *UnifiedCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.ethStakingPool
 	ch <- c.ethMaxValidators
 	ch <- c.ethValidatorCount
+	ch <- c.ethValidatorRegistered
+	ch <- c.ethValidatorStatus
+}
+
+// SetEthereumHTTPClient sets the http.Client used for Ethereum JSON-RPC calls (e.g. with an mTLS client certificate)
+func (c *UnifiedCollector) SetEthereumHTTPClient(httpClient *http.Client) {
+	c.ethHTTPClient = httpClient
+}
+
+// SetSuccessRatioWindow sets the number of recent collections in zerog_collection_success_ratio (<= 0 = default).
+// Call it before collection starts; it drops the recorded outcomes
+func (c *UnifiedCollector) SetSuccessRatioWindow(size int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.successWindow = newSuccessWindow(size)
+}
+
+// SetCollectionTimeout sets the deadline of one collection (<= 0 = default). Every request of the collection is
+// canceled when it expires. Call it before collection starts
+func (c *UnifiedCollector) SetCollectionTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = config.DefaultCollectionTimeout * time.Second
+	}
+	c.collectionTimeout = timeout
+}
+
+// SetLogger routes the collector's and block tracker's logs to logger. Call it before collection starts
+func (c *UnifiedCollector) SetLogger(logger *slog.Logger) {
+	c.logger.slog = logger
+}
+
+// SetConcurrencyLimiter shares a limiter across collectors to bound concurrent chain collections
+func (c *UnifiedCollector) SetConcurrencyLimiter(limiter ConcurrencyLimiter) {
+	c.limiter = limiter
+}
+
+// StartBlockTracking runs the background block tracker until ctx is cancelled (no-op when block tracking is disabled)
+func (c *UnifiedCollector) StartBlockTracking(ctx context.Context) {
+	if c.blockTracker == nil {
+		return
+	}
+	c.blockTracker.Run(ctx)
+}
+
+// StartBackgroundCollection refreshes the cached metrics every interval until ctx is cancelled.
+// Once started, Collect serves the cached metrics instead of querying the node on every scrape.
+func (c *UnifiedCollector) StartBackgroundCollection(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.refresh()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refresh runs a full collection and stores the resulting metrics for Collect to serve
+func (c *UnifiedCollector) refresh() {
+	metricCh := make(chan prometheus.Metric, 256)
+	done := make(chan struct{})
+
+	var metrics []prometheus.Metric
+	go func() {
+		for metric := range metricCh {
+			metrics = append(metrics, metric)
+		}
+		close(done)
+	}()
+
+	c.collectAll(metricCh)
+	close(metricCh)
+	<-done
+
+	c.mu.Lock()
+	c.cachedMetrics = metrics
+	c.mu.Unlock()
 }
 
 // Collect implements prometheus.Collector
 func (c *UnifiedCollector) Collect(ch chan<- prometheus.Metric) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	c.mu.Lock()
+	cachedMetrics := c.cachedMetrics
+	c.mu.Unlock()
+
+	// background collection이 아직 돌지 않았으면 동기 수집
+	if cachedMetrics == nil {
+		c.collectAll(ch)
+	} else {
+		for _, metric := range cachedMetrics {
+			ch <- metric
+		}
+	}
+
+	// 캐시된 값의 신선도 (성공한 수집이 없으면 생략)
+	c.mu.Lock()
+	lastSuccess := c.lastSuccess
+	c.mu.Unlock()
+	if !lastSuccess.IsZero() {
+		ch <- prometheus.MustNewConstMetric(c.metricsAge, prometheus.GaugeValue, time.Since(lastSuccess).Seconds(), c.cfg.ChainID)
+	}
+
+	// block tracker 상태와 block time histogram은 항상 최신 값으로 emit
+	c.collectBlockTrackerMetrics(ch)
+	c.blockTimeHistogram.Collect(ch)
+	c.collectionDuration.Collect(ch)
+}
+
+// collectAll queries the node and emits every metric of this collector
+func (c *UnifiedCollector) collectAll(ch chan<- prometheus.Metric) {
+	// 다른 체인 수집과 동시 실행 수 제한 (대기 시간은 수집 timeout에 포함하지 않음)
+	c.limiter.acquire()
+	defer c.limiter.release()
+
+	start := time.Now()
+	scrapeID := newScrapeID()
+	c.mu.Lock()
+	c.scrapeID = scrapeID
+	c.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.collectionTimeout)
 	defer cancel()
 
-	g, _ := errgroup.WithContext(ctx)
-	g.Go(func() error { return c.collectCosmosMetrics(ctx, ch) })
-	g.Go(func() error { return c.collectEthereumMetrics(ch) })
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return c.runSubsystem("cosmos", func() error { return c.collectCosmosMetrics(gctx, ch) })
+	})
+	g.Go(func() error {
+		return c.runSubsystem("ethereum", func() error { return c.collectEthereumMetrics(gctx, ch) })
+	})
 
-	if err := g.Wait(); err != nil {
+	err := g.Wait()
+	if err != nil {
 		c.logger.Error("Error collecting metrics", "error", err)
 	}
+
+	c.mu.Lock()
+	c.lastCollectErr = err
+	c.collected = true
+	if err == nil {
+		c.lastSuccess = time.Now()
+	}
+	c.successWindow.record(err == nil)
+	if ratio, ok := c.successWindow.ratio(); ok {
+		ch <- prometheus.MustNewConstMetric(c.collectionSuccessRatio, prometheus.GaugeValue, ratio, c.cfg.ChainID)
+	}
+	c.observe(c.collectionDuration, time.Since(start).Seconds(), prometheus.Labels{"scrape_id": scrapeID})
+	if ctx.Err() == context.DeadlineExceeded {
+		c.scrapeTimeouts++
+	}
+	for endpoint, count := range c.collectionErrors {
+		ch <- prometheus.MustNewConstMetric(c.collectionErrorsTotal, prometheus.CounterValue, count, c.cfg.ChainID, endpoint)
+	}
+	for subsystem, lastSuccess := range c.subsystemLastSuccess {
+		ch <- prometheus.MustNewConstMetric(c.subsystemLastSuccessTimestamp, prometheus.GaugeValue, float64(lastSuccess.Unix()), c.cfg.ChainID, subsystem)
+	}
+	ch <- prometheus.MustNewConstMetric(c.scrapeTimeoutTotal, prometheus.CounterValue, c.scrapeTimeouts, c.cfg.ChainID)
+	c.mu.Unlock()
+
+	// 에러 메시지 전체 대신 정규화된 사유만 label로 사용 (cardinality 제한)
+	ch <- prometheus.MustNewConstMetric(c.chainLastError, prometheus.GaugeValue, 1, c.cfg.ChainID, rpc.ErrorReason(err))
+
+	hits, misses := c.client.CacheStats()
+	ch <- prometheus.MustNewConstMetric(c.rpcCacheHits, prometheus.CounterValue, float64(hits), c.cfg.ChainID)
+	ch <- prometheus.MustNewConstMetric(c.rpcCacheMisses, prometheus.CounterValue, float64(misses), c.cfg.ChainID)
+
+	for endpoint, open := range c.client.CircuitStates() {
+		circuitOpen := 0.0
+		if open {
+			circuitOpen = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.endpointCircuitOpen, prometheus.GaugeValue, circuitOpen, c.cfg.ChainID, endpoint)
+	}
+}
+
+// runSubsystem runs a subsystem collection and records the time of its last successful run
+func (c *UnifiedCollector) runSubsystem(subsystem string, collect func() error) error {
+	if err := collect(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.subsystemLastSuccess[subsystem] = time.Now()
+	c.mu.Unlock()
+	return nil
+}
+
+// recordCollectionError increments the collection error counter for an endpoint
+func (c *UnifiedCollector) recordCollectionError(endpoint string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.collectionErrors[endpoint]++
+}
+
+// recordFetchError logs a failed endpoint fetch and counts it in zerog_collection_errors_total. Endpoints the
+// chain does not serve (HTTP 404/501, e.g. a disabled module) are skipped quietly; it returns false in that case.
+func (c *UnifiedCollector) recordFetchError(endpoint string, err error) bool {
+	if rpc.IsNotSupported(err) {
+		c.logger.Debug("Endpoint not supported on this chain, skipping", "chain_id", c.cfg.ChainID, "endpoint", endpoint, "error", err)
+		return false
+	}
+	if rpc.IsCircuitOpen(err) {
+		// 장애 중 매 scrape마다 에러 로그가 쌓이지 않도록 debug로만 기록
+		c.logger.Debug("Circuit breaker open, skipping endpoint", "chain_id", c.cfg.ChainID, "endpoint", endpoint, "error", err)
+		c.recordCollectionError(endpoint)
+		return true
+	}
+
+	c.logger.Error("Failed to fetch endpoint", "chain_id", c.cfg.ChainID, "endpoint", endpoint, "error", err)
+	c.recordCollectionError(endpoint)
+	return true
+}
+
+// recordMintError handles a failed mint query. Chains without the mint module get a one-time warning
+// instead of an error on every collection.
+func (c *UnifiedCollector) recordMintError(endpoint string, err error) {
+	if rpc.IsNotSupported(err) {
+		c.mintAbsentOnce.Do(func() {
+			c.logger.Warn("Mint module not available, skipping inflation and provisions metrics (set inflation_path / annual_provisions_path for a custom endpoint)", "chain_id", c.cfg.ChainID, "error", err)
+		})
+		return
+	}
+	c.recordFetchError(endpoint, err)
+}
+
+// WarmUpBlockTime seeds the block time calculator with the header time delta of the latest two blocks so
+// cosmos_avg_block_time is available from the first scrape. Call it before background collection starts;
+// nodes that pruned the previous block are skipped without an error.
+func (c *UnifiedCollector) WarmUpBlockTime() error {
+	status, err := c.client.GetStatus()
+	if err != nil {
+		return err
+	}
+	latestHeight, err := util.ParseBlockHeight(status.Result.SyncInfo.LatestBlockHeight)
+	if err != nil {
+		return err
+	}
+	if latestHeight < 2 {
+		return nil
+	}
+
+	latest, err := c.client.GetBlock(int(latestHeight))
+	if err != nil {
+		return err
+	}
+	previous, err := c.client.GetBlock(int(latestHeight - 1))
+	var heightErr *rpc.HeightNotAvailableError
+	if errors.As(err, &heightErr) {
+		// pruned 노드 - 이전 블록이 없으면 첫 샘플을 기다림
+		c.logger.Debug("Previous block not available, skipping block time warm-up", "chain_id", c.cfg.ChainID, "height", latestHeight-1)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	latestTime, err := util.ParseBlockTime(latest.Result.Block.Header.Time)
+	if err != nil {
+		return err
+	}
+	previousTime, err := util.ParseBlockTime(previous.Result.Block.Header.Time)
+	if err != nil {
+		return err
+	}
+	if !latestTime.After(previousTime) {
+		return nil
+	}
+
+	c.blockTimeCalculator.UpdateBlockTime(latestHeight-1, previousTime)
+	c.blockTimeCalculator.UpdateBlockTime(latestHeight, latestTime)
+	c.logger.Info("Seeded block time from the latest blocks", "chain_id", c.cfg.ChainID, "height", latestHeight, "block_time", latestTime.Sub(previousTime).String())
+	return nil
+}
+
+// queryPrometheusBackref reads this chain's last average block time back from the Prometheus server and emits
+// zerog_prometheus_backref_up. Failures never fail the collection; nothing is emitted when no server is configured.
+func (c *UnifiedCollector) queryPrometheusBackref(ch chan<- prometheus.Metric) (time.Duration, bool) {
+	if c.prometheusClient == nil {
+		return 0, false
+	}
+
+	avgBlockTime, err := c.prometheusClient.GetAverageBlockTime(c.cfg.ChainID)
+	up := 1.0
+	if err != nil && !errors.Is(err, util.ErrNoPrometheusResults) {
+		up = 0
+		c.logger.Debug("Prometheus backref query failed", "chain_id", c.cfg.ChainID, "error", err)
+	}
+	ch <- prometheus.MustNewConstMetric(c.prometheusBackrefUp, prometheus.GaugeValue, up, c.cfg.ChainID)
+
+	return avgBlockTime, err == nil
+}
+
+// recordPrunedHeight remembers the lowest height the node retains so later scans stay inside it, and warns once
+func (c *UnifiedCollector) recordPrunedHeight(heightErr *rpc.HeightNotAvailableError) {
+	lowest := heightErr.LowestHeight
+	if lowest <= 0 {
+		lowest = heightErr.Height + 1
+	}
+
+	c.mu.Lock()
+	if lowest > c.lowestRetainedHeight {
+		c.lowestRetainedHeight = lowest
+	}
+	c.mu.Unlock()
+
+	c.prunedWarnOnce.Do(func() {
+		c.logger.Warn("Node is pruned, shrinking the block scan window to retained blocks; signing_infos based metrics (cosmos_validator_missed_blocks, cosmos_validator_uptime_ratio) are more reliable", "chain_id", c.cfg.ChainID, "lowest_height", lowest)
+	})
+}
+
+// LastCollectError returns the error of the most recent Collect, or nil if it succeeded
+func (c *UnifiedCollector) LastCollectError() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastCollectErr
+}
+
+// ReadyStatus reports whether the last collection succeeded (false before the first collection),
+// the time of the last successful collection (zero if none) and the last collection error
+func (c *UnifiedCollector) ReadyStatus() (bool, time.Time, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.collected && c.lastCollectErr == nil, c.lastSuccess, c.lastCollectErr
+}
+
+// ChainID returns the chain id this collector scrapes
+func (c *UnifiedCollector) ChainID() string {
+	return c.cfg.ChainID
+}
+
+// collectBlockTrackerMetrics emits the live consecutive-miss state maintained by the background block tracker
+func (c *UnifiedCollector) collectBlockTrackerMetrics(ch chan<- prometheus.Metric) {
+	if c.blockTracker == nil {
+		return
+	}
+
+	for validatorAddr, missed := range c.blockTracker.ConsecutiveMissed() {
+		missAlert := 0.0
+		if c.blockTracker.MissAlert(missed) {
+			missAlert = 1.0
+		}
+		ch <- prometheus.MustNewConstMetric(c.validatorConsecutiveMissed, prometheus.GaugeValue, float64(missed), c.cfg.ChainID, validatorAddr)
+		ch <- prometheus.MustNewConstMetric(c.validatorMissAlert, prometheus.GaugeValue, missAlert, c.cfg.ChainID, validatorAddr)
+	}
+
+	if c.blockTracker.wsURL != "" {
+		connected, reconnects := c.blockTracker.WebSocketStatus()
+		wsConnected := 0.0
+		if connected {
+			wsConnected = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.wsConnected, prometheus.GaugeValue, wsConnected, c.cfg.ChainID)
+		ch <- prometheus.MustNewConstMetric(c.wsReconnectsTotal, prometheus.CounterValue, float64(reconnects), c.cfg.ChainID)
+	}
 }
 
 // collectCosmosMetrics collects metrics from Cosmos SDK
 func (c *UnifiedCollector) collectCosmosMetrics(ctx context.Context, ch chan<- prometheus.Metric) error {
+	client := c.client.WithContext(ctx)
+	// 개별 endpoint 실패는 관련 메트릭 그룹만 건너뛰고 나머지는 계속 수집
+	var collectErr error
+
 	// Get node status
-	status, err := c.client.GetStatus()
+	var latestHeight int64
+	var network string
+	status, err := client.GetStatus()
 	if err != nil {
 		c.logger.Error("Failed to get node status", "error", err)
-		return err
+		c.recordCollectionError("status")
+		collectErr = err
+	} else {
+		if h, err := strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64); err == nil {
+			latestHeight = h
+		}
+		// 이중화된 노드 구분용 (node id / moniker)
+		nodeStatus := status.Result.NodeInfo
+		network = nodeStatus.Network
+		ch <- prometheus.MustNewConstMetric(c.nodeStatusInfo, prometheus.GaugeValue, 1, c.cfg.ChainID, nodeStatus.ID, nodeStatus.Moniker, nodeStatus.Version)
 	}
+	ch <- prometheus.MustNewConstMetric(c.chainInfo, prometheus.GaugeValue, 1, c.cfg.ChainID, strings.TrimSpace(c.cfg.Name), network)
 
-	// Block time metrics (using current time since LatestBlockTime is not available)
-	currentTime := time.Now()
-	ch <- prometheus.MustNewConstMetric(c.cosmosBlockTime, prometheus.GaugeValue, float64(currentTime.Unix()), c.cfg.ChainID)
-	
-	// Update block time calculator
-	if height, err := strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64); err == nil {
-		c.blockTimeCalculator.UpdateBlockTime(height, currentTime)
+	// 여러 RPC endpoint 간 height 비교 (lagging / fork 감지)
+	c.collectEndpointHeightMetrics(ctx, ch)
+
+	// Block time metrics (최신 블록 header 시간, warm-up과 같은 기준)
+	if status != nil && latestHeight > 0 {
+		if latestBlockTime, err := util.ParseBlockTime(status.Result.SyncInfo.LatestBlockTime); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.cosmosBlockTime, prometheus.GaugeValue, float64(latestBlockTime.Unix()), c.cfg.ChainID)
+			c.blockTimeCalculator.UpdateBlockTime(latestHeight, latestBlockTime)
+		} else {
+			c.logger.Debug("Failed to parse latest block time", "chain_id", c.cfg.ChainID, "error", err)
+		}
 	}
 	
-	// Average block time
-	if avgBlockTime := c.blockTimeCalculator.GetAverageBlockTime(); avgBlockTime > 0 {
+	// Average block time (샘플이 없으면 Prometheus에 저장된 이전 값 사용).
+	// cosmos_block_time_seconds histogram 이전의 gauge로, avg_block_time_gauge: false면 생략
+	avgBlockTime := c.blockTimeCalculator.GetAverageBlockTime()
+	if backrefAvg, ok := c.queryPrometheusBackref(ch); ok && avgBlockTime <= 0 {
+		avgBlockTime = backrefAvg
+	}
+	if avgBlockTime > 0 && c.avgBlockTimeGauge {
 		ch <- prometheus.MustNewConstMetric(c.cosmosAvgBlockTime, prometheus.GaugeValue, avgBlockTime.Seconds(), c.cfg.ChainID)
 	}
+	if emaBlockTime := c.blockTimeCalculator.GetEMABlockTime(); emaBlockTime > 0 {
+		ch <- prometheus.MustNewConstMetric(c.cosmosBlockTimeEMA, prometheus.GaugeValue, emaBlockTime.Seconds(), c.cfg.ChainID)
+	}
 	
 	// Time since last block
 	if timeSinceLastBlock := c.blockTimeCalculator.GetLatestBlockTime(); timeSinceLastBlock > 0 {
 		ch <- prometheus.MustNewConstMetric(c.cosmosTimeSinceLastBlock, prometheus.GaugeValue, timeSinceLastBlock.Seconds(), c.cfg.ChainID)
 	}
 
-	// Validator statistics from latest block signatures
-	activeValidators := 0
-	inactiveValidators := 0
-	totalValidators := 0
+	// Node info (app / sdk version)
+	if nodeInfo, err := client.GetNodeInfo(); err == nil {
+		appVersion := nodeInfo.ApplicationVersion
+		ch <- prometheus.MustNewConstMetric(c.nodeInfo, prometheus.GaugeValue, 1, c.cfg.ChainID, appVersion.AppName, appVersion.Version, appVersion.GitCommit, appVersion.CosmosSDKVersion, nodeInfo.DefaultNodeInfo.Network)
+
+		// 설정한 chain_id와 노드의 network 비교
+		chainIDMatch := 0.0
+		if nodeInfo.DefaultNodeInfo.Network == c.cfg.ChainID {
+			chainIDMatch = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.chainIDMatch, prometheus.GaugeValue, chainIDMatch, c.cfg.ChainID, nodeInfo.DefaultNodeInfo.Network)
+	} else {
+		c.recordFetchError("node_info", err)
+	}
+
+	// Block signing participation from latest block signatures
+	signedSignatures := 0
+	totalSignatures := 0
 	
-	if currentHeight, err := strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64); err == nil {
-		if block, err := c.client.GetBlock(int(currentHeight)); err == nil {
+	if latestHeight > 0 {
+		if block, err := client.GetBlock(int(latestHeight)); err == nil && hasLastCommit(block) {
 			// block_id_flag 분석
 			// 1 = Precommit (이전 블록 서명)
-			// 4 = Commit (현재 블록 서명) - Active
-			// 5 = Absent (서명 안됨) - Inactive
+			// 4 = Commit (현재 블록 서명)
+			// 5 = Absent (서명 안됨)
 			for _, sig := range block.Result.Block.LastCommit.Signatures {
-				totalValidators++
+				totalSignatures++
 				if sig.BlockIDFlag == 4 {
-					activeValidators++
-				} else if sig.BlockIDFlag == 5 {
-					inactiveValidators++
+					signedSignatures++
 				}
 			}
 		}
 	}
 
-	// Validator statistics
-	ch <- prometheus.MustNewConstMetric(c.validatorsTotal, prometheus.GaugeValue, float64(totalValidators), c.cfg.ChainID)
-	ch <- prometheus.MustNewConstMetric(c.validatorsActive, prometheus.GaugeValue, float64(activeValidators), c.cfg.ChainID)
-	ch <- prometheus.MustNewConstMetric(c.validatorsInactive, prometheus.GaugeValue, float64(inactiveValidators), c.cfg.ChainID)
+	// Validator statistics - staking set 기준 (BOND_STATUS_BONDED = active)
+	validators, validatorsErr := client.GetValidators()
+	if validatorsErr == nil {
+		truncated := 0.0
+		if validators.Truncated {
+			truncated = 1
+			c.truncatedWarnOnce.Do(func() {
+				c.logger.Warn("Validator set truncated by max_validators, skipping network-wide validator metrics", "chain_id", c.cfg.ChainID, "max_validators", c.cfg.MaxValidators)
+			})
+		}
+		ch <- prometheus.MustNewConstMetric(c.validatorsTruncated, prometheus.GaugeValue, truncated, c.cfg.ChainID)
+
+		// 잘린 목록으로 계산한 네트워크 전체 값은 틀리므로 emit하지 않음
+		if !validators.Truncated {
+			activeValidators, inactiveValidators := countValidatorsByStatus(validators)
+			ch <- prometheus.MustNewConstMetric(c.validatorsTotal, prometheus.GaugeValue, float64(len(validators.Validators)), c.cfg.ChainID)
+			ch <- prometheus.MustNewConstMetric(c.validatorsActive, prometheus.GaugeValue, float64(activeValidators), c.cfg.ChainID)
+			ch <- prometheus.MustNewConstMetric(c.validatorsInactive, prometheus.GaugeValue, float64(inactiveValidators), c.cfg.ChainID)
+
+			// 네트워크 commission (validators 캐시 TTL 동안 같은 목록으로 계산)
+			if avg, ok := weightedCommission(validators); ok {
+				ch <- prometheus.MustNewConstMetric(c.networkAvgCommission, prometheus.GaugeValue, avg, c.cfg.ChainID)
+			}
+			if median, ok := medianCommission(validators); ok {
+				ch <- prometheus.MustNewConstMetric(c.networkMedianCommission, prometheus.GaugeValue, median, c.cfg.ChainID)
+			}
+		}
+	}
 	
-	// Bonded ratio 계산
-	bondedRatio := 0.0
-	if totalValidators > 0 {
-		bondedRatio = float64(activeValidators) / float64(totalValidators)
+	// Block signing ratio 계산 (서명 참여율 - bonded ratio 아님)
+	// last commit이 비어 있으면 (genesis 등) 0 대신 이전 값, 이전 값도 없으면 NaN
+	signingRatio := math.NaN()
+	c.mu.Lock()
+	if totalSignatures > 0 {
+		signingRatio = float64(signedSignatures) / float64(totalSignatures)
+		c.lastSigningRatio = signingRatio
+		c.hasSigningRatio = true
+	} else if c.hasSigningRatio {
+		signingRatio = c.lastSigningRatio
 	}
-	ch <- prometheus.MustNewConstMetric(c.validatorsBondedRatio, prometheus.GaugeValue, bondedRatio, c.cfg.ChainID)
+	c.mu.Unlock()
+	ch <- prometheus.MustNewConstMetric(c.blockSigningRatio, prometheus.GaugeValue, signingRatio, c.cfg.ChainID)
 	
 
 	
 
 
+	// Staking denom (bond_denom) - bank supply에서 스테이킹 토큰만 골라내기 위해 사용
+	stakingParams, stakingParamsErr := client.GetStakingParams()
+	stakingDenom := c.cfg.TokenBase
+	if stakingParamsErr == nil && stakingParams.Params.BondDenom != "" {
+		stakingDenom = stakingParams.Params.BondDenom
+		c.mu.Lock()
+		c.bondDenom = stakingDenom
+		c.mu.Unlock()
+	}
+	stakingDenomInfo := c.denomInfo(stakingDenom)
+	c.collectDenomInfo(ch, stakingDenom)
+
 	// Supply & Pool metrics - 실제 API 호출로 데이터 수집
-	if stakingPool, err := c.client.GetStakingPool(); err == nil {
-		if bondedTokens, err := strconv.ParseInt(stakingPool.Pool.BondedTokens, 10, 64); err == nil {
-			bondedTokensFloat := convertFromBaseUnit(bondedTokens, c.cfg.TokenDecimals)
-			ch <- prometheus.MustNewConstMetric(c.bondedTokens, prometheus.GaugeValue, bondedTokensFloat, c.cfg.ChainID, "0G")
+	bondedTokensRaw := -1.0
+	bondedTokens := ""
+	if stakingPool, err := client.GetStakingPool(); err == nil {
+		bondedTokens = stakingPool.Pool.BondedTokens
+		if bonded, err := strconv.ParseFloat(stakingPool.Pool.BondedTokens, 64); err == nil {
+			bondedTokensRaw = bonded
 		}
-		if notBondedTokens, err := strconv.ParseInt(stakingPool.Pool.NotBondedTokens, 10, 64); err == nil {
-			notBondedTokensFloat := convertFromBaseUnit(notBondedTokens, c.cfg.TokenDecimals)
-			ch <- prometheus.MustNewConstMetric(c.notBondedTokens, prometheus.GaugeValue, notBondedTokensFloat, c.cfg.ChainID, "0G")
+		if bondedTokensFloat, err := util.ScaleAmount(stakingPool.Pool.BondedTokens, stakingDenomInfo.decimals); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.bondedTokens, prometheus.GaugeValue, bondedTokensFloat, c.cfg.ChainID, stakingDenomInfo.display)
+		}
+		if notBondedTokensFloat, err := util.ScaleAmount(stakingPool.Pool.NotBondedTokens, stakingDenomInfo.decimals); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.notBondedTokens, prometheus.GaugeValue, notBondedTokensFloat, c.cfg.ChainID, stakingDenomInfo.display)
 		}
 	}
 
 	// Community Pool
-	if communityPool, err := c.client.GetCommunityPool(); err == nil {
+	if communityPool, err := client.GetCommunityPool(); err == nil {
 		for _, pool := range communityPool.Pool {
-			if amount, err := strconv.ParseInt(pool.Amount, 10, 64); err == nil {
-				amountFloat := convertFromBaseUnit(amount, c.cfg.TokenDecimals)
-				ch <- prometheus.MustNewConstMetric(c.communityPool, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, pool.Denom)
+			if !c.denomAllowed(pool.Denom) {
+				continue
+			}
+			info := c.denomInfo(pool.Denom)
+			if amountFloat, err := util.ScaleAmount(pool.Amount, info.decimals); err == nil {
+				ch <- prometheus.MustNewConstMetric(c.communityPool, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, info.display)
 			}
 		}
 	}
 
 	// Bank Supply
-	if bankSupply, err := c.client.GetBankSupply(); err == nil {
+	if bankSupply, err := client.GetBankSupply(); err == nil {
 		for _, supply := range bankSupply.Supply {
-			if amount, err := strconv.ParseInt(supply.Amount, 10, 64); err == nil {
-				amountFloat := convertFromBaseUnit(amount, c.cfg.TokenDecimals)
-				ch <- prometheus.MustNewConstMetric(c.supplyTotal, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, supply.Denom)
+			// Bonded / supply ratio (staking denom만 사용, supply가 0이면 생략)
+			if supply.Denom == stakingDenom && bondedTokensRaw >= 0 {
+				if supplyRaw, err := strconv.ParseFloat(supply.Amount, 64); err == nil && supplyRaw > 0 {
+					bondedRatio := bondedTokensRaw / supplyRaw
+					ch <- prometheus.MustNewConstMetric(c.bondedSupplyRatio, prometheus.GaugeValue, bondedRatio, c.cfg.ChainID)
+					// deprecated alias (이전 이름 호환)
+					ch <- prometheus.MustNewConstMetric(c.validatorsBondedRatio, prometheus.GaugeValue, bondedRatio, c.cfg.ChainID)
+				}
+			}
+			if !c.denomAllowed(supply.Denom) {
+				continue
+			}
+			info := c.denomInfo(supply.Denom)
+			if amountFloat, err := util.ScaleAmount(supply.Amount, info.decimals); err == nil {
+				ch <- prometheus.MustNewConstMetric(c.supplyTotal, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, info.display)
 			}
 		}
 	}
 
 	// Inflation
-	if inflation, err := c.client.GetMintingInflation(); err == nil {
+	if inflation, err := client.GetMintingInflation(); err == nil {
 		if inflationRate, err := strconv.ParseFloat(inflation.Inflation, 64); err == nil {
 			ch <- prometheus.MustNewConstMetric(c.inflation, prometheus.GaugeValue, inflationRate, c.cfg.ChainID)
 		}
+	} else {
+		c.recordMintError("mint_inflation", err)
 	}
 
 	// Annual Provisions
-	if annualProvisions, err := c.client.GetMintingAnnualProvisions(); err == nil {
-		if provisions, err := strconv.ParseInt(annualProvisions.AnnualProvisions, 10, 64); err == nil {
-			provisionsFloat := convertFromBaseUnit(provisions, c.cfg.TokenDecimals)
-			ch <- prometheus.MustNewConstMetric(c.annualProvisions, prometheus.GaugeValue, provisionsFloat, c.cfg.ChainID, "0G")
-		}
-	}
-
-	// Wallet metrics - 실제 API 호출로 데이터 수집
-	for _, wallet := range c.cfg.Wallets {
-		// Wallet Balance
-		if balance, err := c.client.GetWalletBalance(wallet.Address); err == nil {
-			for _, bal := range balance.Balances {
-				if amount, err := strconv.ParseInt(bal.Amount, 10, 64); err == nil {
-					amountFloat := convertFromBaseUnit(amount, c.cfg.TokenDecimals)
-					ch <- prometheus.MustNewConstMetric(c.walletBalance, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, wallet.Address, bal.Denom)
-				}
-			}
-		}
-
-		// Wallet Delegations
-		if delegations, err := c.client.GetWalletDelegations(wallet.Address); err == nil {
-			for _, del := range delegations.DelegationResponses {
-				if amount, err := strconv.ParseInt(del.Balance.Amount, 10, 64); err == nil {
-					amountFloat := convertFromBaseUnit(amount, c.cfg.TokenDecimals)
-					ch <- prometheus.MustNewConstMetric(c.walletDelegations, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, wallet.Address, del.Balance.Denom)
-				}
-			}
-		}
-
-		// Wallet Rewards
-		if rewards, err := c.client.GetWalletRewards(wallet.Address); err == nil {
-			for _, reward := range rewards.Rewards {
-				for _, r := range reward.Reward {
-					if amount, err := strconv.ParseInt(r.Amount, 10, 64); err == nil {
-						amountFloat := convertFromBaseUnit(amount, c.cfg.TokenDecimals)
-						ch <- prometheus.MustNewConstMetric(c.walletRewards, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, wallet.Address, r.Denom)
-					}
-				}
-			}
-		}
-
-		// Wallet Unbonding
-		if unbonding, err := c.client.GetWalletUnbonding(wallet.Address); err == nil {
-			for _, ub := range unbonding.UnbondingResponses {
-				for _, entry := range ub.Entries {
-					if amount, err := strconv.ParseInt(entry.Balance, 10, 64); err == nil {
-						amountFloat := convertFromBaseUnit(amount, c.cfg.TokenDecimals)
-						ch <- prometheus.MustNewConstMetric(c.walletUnbonding, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, wallet.Address, "0G")
-					}
-				}
+	// annual_provisions는 소수점 문자열("123.450000000000000000")이므로 float로 파싱
+	if annualProvisions, err := client.GetMintingAnnualProvisions(); err == nil {
+		if provisionsFloat, err := util.ScaleAmount(annualProvisions.AnnualProvisions, stakingDenomInfo.decimals); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.annualProvisions, prometheus.GaugeValue, provisionsFloat, c.cfg.ChainID, stakingDenomInfo.display)
+
+			// Provisions per block (평균 block time 측정 전에는 NaN)
+			blocksPerYear := math.NaN()
+			if avgBlockTime := c.blockTimeCalculator.GetAverageBlockTime(); avgBlockTime > 0 {
+				blocksPerYear = secondsPerYear / avgBlockTime.Seconds()
 			}
+			ch <- prometheus.MustNewConstMetric(c.blocksPerYearEstimate, prometheus.GaugeValue, blocksPerYear, c.cfg.ChainID)
+			ch <- prometheus.MustNewConstMetric(c.provisionsPerBlock, prometheus.GaugeValue, provisionsFloat/blocksPerYear, c.cfg.ChainID, stakingDenomInfo.display)
 		}
+	} else {
+		c.recordMintError("mint_annual_provisions", err)
 	}
 
+	// Wallet metrics - 지갑별 조회는 병렬로 수행
+	c.collectWalletMetrics(ctx, ch, stakingDenom, stakingDenomInfo)
+
 	// Chain parameters - 실제 API 호출로 데이터 수집
 	// Slashing Parameters
-	if slashingParams, err := c.client.GetSlashingParams(); err == nil {
-		if signedBlocksWindow, err := strconv.ParseInt(slashingParams.Params.SignedBlocksWindow, 10, 64); err == nil {
+	if slashingParams, err := client.GetSlashingParams(); err == nil {
+		if signedBlocksWindow, err := util.ParseIntParam(slashingParams.Params.SignedBlocksWindow); err == nil {
 			ch <- prometheus.MustNewConstMetric(c.paramsSignedBlocksWindow, prometheus.GaugeValue, float64(signedBlocksWindow), c.cfg.ChainID)
 		}
-		if minSignedPerWindow, err := strconv.ParseFloat(slashingParams.Params.MinSignedPerWindow, 64); err == nil {
+		if minSignedPerWindow, err := util.ParseDecimalParam(slashingParams.Params.MinSignedPerWindow); err == nil {
 			ch <- prometheus.MustNewConstMetric(c.paramsMinSignedPerWindow, prometheus.GaugeValue, minSignedPerWindow, c.cfg.ChainID)
 		}
-		if downtimeJailDuration, err := strconv.ParseFloat(slashingParams.Params.DowntimeJailDuration, 64); err == nil {
-			ch <- prometheus.MustNewConstMetric(c.paramsDowntimeJailDuration, prometheus.GaugeValue, downtimeJailDuration, c.cfg.ChainID)
+		if downtimeJailDuration, err := util.ParseDurationParam(slashingParams.Params.DowntimeJailDuration); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.paramsDowntimeJailDuration, prometheus.GaugeValue, downtimeJailDuration.Seconds(), c.cfg.ChainID)
+			if jailBlocks := util.CalculateDowntimeThreshold(downtimeJailDuration.Seconds(), c.blockTimeCalculator.GetAverageBlockTime()); jailBlocks > 0 {
+				ch <- prometheus.MustNewConstMetric(c.paramsDowntimeJailBlocks, prometheus.GaugeValue, float64(jailBlocks), c.cfg.ChainID)
+			}
 		}
-		if slashFractionDoubleSign, err := strconv.ParseFloat(slashingParams.Params.SlashFractionDoubleSign, 64); err == nil {
+		if slashFractionDoubleSign, err := util.ParseDecimalParam(slashingParams.Params.SlashFractionDoubleSign); err == nil {
 			ch <- prometheus.MustNewConstMetric(c.paramsSlashFractionDoubleSign, prometheus.GaugeValue, slashFractionDoubleSign, c.cfg.ChainID)
 		}
-		if slashFractionDowntime, err := strconv.ParseFloat(slashingParams.Params.SlashFractionDowntime, 64); err == nil {
+		if slashFractionDowntime, err := util.ParseDecimalParam(slashingParams.Params.SlashFractionDowntime); err == nil {
 			ch <- prometheus.MustNewConstMetric(c.paramsSlashFractionDowntime, prometheus.GaugeValue, slashFractionDowntime, c.cfg.ChainID)
 		}
 	}
 
 	// Staking Parameters
-	if stakingParams, err := c.client.GetStakingParams(); err == nil {
+	if stakingParamsErr == nil {
 		ch <- prometheus.MustNewConstMetric(c.paramsMaxValidators, prometheus.GaugeValue, float64(stakingParams.Params.MaxValidators), c.cfg.ChainID)
 	}
 
 	// Distribution Parameters
-	if distributionParams, err := c.client.GetDistributionParams(); err == nil {
-		if baseProposerReward, err := strconv.ParseFloat(distributionParams.Params.BaseProposerReward, 64); err == nil {
+	if distributionParams, err := client.GetDistributionParams(); err == nil {
+		if baseProposerReward, err := util.ParseDecimalParam(distributionParams.Params.BaseProposerReward); err == nil {
 			ch <- prometheus.MustNewConstMetric(c.paramsBaseProposerReward, prometheus.GaugeValue, baseProposerReward, c.cfg.ChainID)
 		}
-		if bonusProposerReward, err := strconv.ParseFloat(distributionParams.Params.BonusProposerReward, 64); err == nil {
+		if bonusProposerReward, err := util.ParseDecimalParam(distributionParams.Params.BonusProposerReward); err == nil {
 			ch <- prometheus.MustNewConstMetric(c.paramsBonusProposerReward, prometheus.GaugeValue, bonusProposerReward, c.cfg.ChainID)
 		}
+		if communityTax, err := util.ParseDecimalParam(distributionParams.Params.CommunityTax); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.paramsCommunityTax, prometheus.GaugeValue, communityTax, c.cfg.ChainID)
+		}
+		withdrawAddrEnabled := 0.0
+		if distributionParams.Params.WithdrawAddrEnabled {
+			withdrawAddrEnabled = 1.0
+		}
+		ch <- prometheus.MustNewConstMetric(c.paramsWithdrawAddrEnabled, prometheus.GaugeValue, withdrawAddrEnabled, c.cfg.ChainID)
+	}
+
+	// IBC client metrics (IBC 모듈이 없는 체인은 건너뜀)
+	c.collectIBCClientMetrics(ctx, ch)
+	c.collectIBCEscrowMetrics(ctx, ch)
+
+	// Slash / liveness events from block results
+	if latestHeight > 0 {
+		c.collectSlashEventMetrics(ctx, ch, latestHeight)
+	}
+
+	// Consensus round/step (opt-in)
+	if c.cfg.ConsensusStateMetrics {
+		c.collectConsensusStateMetrics(ctx, ch)
 	}
 
 	// Governance metrics - 실제 API 호출로 데이터 수집
-	if proposals, err := c.client.GetGovernanceProposals(); err == nil {
+	if proposals, err := client.GetGovernanceProposals(); err == nil {
 		proposalCounts := make(map[string]int)
 		for _, proposal := range proposals.Proposals {
 			proposalCounts[proposal.Status]++
@@ -499,6 +1387,14 @@ func (c *UnifiedCollector) collectCosmosMetrics(ctx context.Context, ch chan<- p
 		for status, count := range proposalCounts {
 			ch <- prometheus.MustNewConstMetric(c.consensusProposalReceiveCount, prometheus.GaugeValue, float64(count), c.cfg.ChainID, status)
 		}
+
+		// Voting period proposal의 turnout / quorum
+		c.collectGovernanceTallyMetrics(ctx, ch, proposals, bondedTokensRaw)
+		c.collectGovernanceDepositMetrics(ctx, ch, proposals)
+	}
+
+	for module, version := range client.APIVersions() {
+		ch <- prometheus.MustNewConstMetric(c.apiVersionUsed, prometheus.GaugeValue, 1, c.cfg.ChainID, module, version)
 	}
 
 	// Tenderduty metrics - 실제 블록 분석 기반
@@ -508,64 +1404,140 @@ func (c *UnifiedCollector) collectCosmosMetrics(ctx context.Context, ch chan<- p
 	maxConsecutiveMissed := 0
 	
 	// Config의 모든 validator 주소들에 대해 분석
-	validatorStats := make(map[string]struct {
-		signedBlocks     int
-		missedBlocks     int
-		consecutiveMissed int
-		maxConsecutiveMissed int
-		proposals        int
-	})
+	validatorStats := make(map[string]validatorBlockStats)
 	
 	// 초기화
 	for _, validatorAddr := range c.cfg.Validators {
-		validatorStats[validatorAddr] = struct {
-			signedBlocks     int
-			missedBlocks     int
-			consecutiveMissed int
-			maxConsecutiveMissed int
-			proposals        int
-		}{}
+		validatorStats[validatorAddr] = validatorBlockStats{}
 	}
 	
-	if currentHeight, err := strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64); err == nil {
-		for i := int64(0); i < 100 && currentHeight-i > 0; i++ {
-			if block, err := c.client.GetBlock(int(currentHeight - i)); err == nil {
+	// 누적 카운터는 이전 scrape 이후 새로 관측된 블록만 반영
+	c.mu.Lock()
+	countedHeight := c.lastCountedHeight
+	c.mu.Unlock()
+	observed := make(map[int64]blockObservation)
+	blockTimes := make(map[int64]time.Time)
+
+	// 스캔 윈도우: block_tracking.scan_window (기본 100블록), pruned 노드는 보존된 블록 범위로 축소
+	scanWindow := c.scanWindow
+	c.mu.Lock()
+	lowestRetained := c.lowestRetainedHeight
+	c.mu.Unlock()
+	if lowestRetained > 0 && latestHeight-lowestRetained+1 < scanWindow {
+		scanWindow = latestHeight - lowestRetained + 1
+	}
+	// scanFloor 이하 블록은 스캔하지 않음 (윈도우 밖 또는 pruned)
+	scanFloor := latestHeight - scanWindow
+
+	scannedBlocks := int64(0)
+	proposerBlocks := int64(0) // proposer를 확인한 블록 수 (조회 실패 블록 제외)
+	if latestHeight > 0 {
+		// 윈도우 블록은 병렬 조회, 통계는 최신 블록부터 순서대로 집계
+		scanned := fetchScanWindow(client, latestHeight, scanWindow)
+		for i, result := range scanned {
+			blockHeight := latestHeight - int64(i)
+			block, err := result.block, result.err
+			var heightErr *rpc.HeightNotAvailableError
+			if errors.As(err, &heightErr) {
+				// pruned 노드 - 더 오래된 블록도 없으므로 스캔 중단
+				if heightErr.Pruned {
+					c.recordPrunedHeight(heightErr)
+				}
+				scanFloor = blockHeight
+				break
+			}
+			// 조회 실패 블록 (timeout, 5xx)은 스캔 범위에 포함하지 않음
+			if err == nil {
+				scannedBlocks++
+				if blockTime, err := util.ParseBlockTime(block.Result.Block.Header.Time); err == nil {
+					blockTimes[blockHeight] = blockTime
+				}
+				var observation blockObservation
+
 				// Proposal 확인
-				proposerAddr := block.Result.Block.Header.ProposerAddress
-				if stats, exists := validatorStats[proposerAddr]; exists {
-					stats.proposals++
-					validatorStats[proposerAddr] = stats
+				proposerHex, err := util.NormalizeConsensusAddress(block.Result.Block.Header.ProposerAddress)
+				if err != nil {
+					proposerHex = strings.ToUpper(block.Result.Block.Header.ProposerAddress)
 				}
-				
-				// 각 validator의 서명 확인
-				for validatorAddr := range validatorStats {
-					hasSigned := false
-					for _, sig := range block.Result.Block.LastCommit.Signatures {
-						if sig.ValidatorAddress == validatorAddr {
-							// block_id_flag: 4 = Commit (서명됨), 5 = Absent (서명 안됨)
-							if sig.BlockIDFlag == 4 {
-								hasSigned = true
-								break
-							}
-						}
+				proposerBlocks++
+				for validatorAddr, stats := range validatorStats {
+					if c.consensusHex(validatorAddr) != proposerHex {
+						continue
 					}
-					
-					stats := validatorStats[validatorAddr]
-					if hasSigned {
-						stats.signedBlocks++
-						stats.consecutiveMissed = 0
-					} else {
-						stats.missedBlocks++
-						stats.consecutiveMissed++
-						if stats.consecutiveMissed > stats.maxConsecutiveMissed {
-							stats.maxConsecutiveMissed = stats.consecutiveMissed
+					stats.proposals++
+					validatorStats[validatorAddr] = stats
+					observation.proposed = append(observation.proposed, validatorAddr)
+				}
+
+				// 빈 last commit은 서명 여부를 알 수 없으므로 signed / missed 집계에서 제외
+				if hasLastCommit(block) {
+					// 각 validator의 서명 확인 (서명 주소는 endpoint에 따라 hex / base64 이므로 hex로 정규화 후 비교)
+					flags := signatureFlags(block)
+					for validatorAddr := range validatorStats {
+						// block_id_flag: 4 = Commit (서명됨), 5 = Absent (서명 안됨)
+						hasSigned := flags[c.consensusHex(validatorAddr)] == 4
+						if hasSigned {
+							observation.signed = append(observation.signed, validatorAddr)
+						} else {
+							observation.missed = append(observation.missed, validatorAddr)
 						}
+
+						stats := validatorStats[validatorAddr]
+						stats.recordSignature(hasSigned)
+						validatorStats[validatorAddr] = stats
 					}
-					validatorStats[validatorAddr] = stats
 				}
+
+				if blockHeight > countedHeight {
+					observed[blockHeight] = observation
+				}
+			}
+		}
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.blockScanWindowActual, prometheus.GaugeValue, float64(scannedBlocks), c.cfg.ChainID)
+
+	// 누적 카운터는 빠짐없이 조회된 블록까지만 반영 - 조회 실패 (timeout, 5xx) 블록부터는 다음 수집에서 다시 스캔.
+	// 스캔 범위 아래로 밀려난 블록은 더 이상 조회하지 않으므로 건너뜀
+	countFrom := countedHeight
+	if scanFloor > countFrom {
+		countFrom = scanFloor
+	}
+	countedUpTo := countFrom
+	for countedUpTo < latestHeight {
+		if _, ok := observed[countedUpTo+1]; !ok {
+			break
+		}
+		countedUpTo++
+	}
+
+	c.mu.Lock()
+	if countedUpTo > countedHeight && c.lastCountedHeight == countedHeight {
+		for height := countFrom + 1; height <= countedUpTo; height++ {
+			observation := observed[height]
+			for _, validatorAddr := range observation.signed {
+				c.signedBlocksTotal[validatorAddr]++
+			}
+			for _, validatorAddr := range observation.missed {
+				c.missedBlocksTotal[validatorAddr]++
+			}
+			for _, validatorAddr := range observation.proposed {
+				c.proposedBlocksTotal[validatorAddr]++
+			}
+			// 새로 관측된 블록의 생성 시간 (이전 블록과의 header time 차이)
+			current, ok := blockTimes[height]
+			previous, prevOk := blockTimes[height-1]
+			if ok && prevOk {
+				c.observe(c.blockTimeHistogram, current.Sub(previous).Seconds(), blockExemplar(c.scrapeID, height))
 			}
 		}
+		c.lastCountedHeight = countedUpTo
+	}
+	for _, validatorAddr := range c.cfg.Validators {
+		ch <- prometheus.MustNewConstMetric(c.validatorSignedBlocksTotal, prometheus.CounterValue, c.signedBlocksTotal[validatorAddr], c.cfg.ChainID, validatorAddr)
+		ch <- prometheus.MustNewConstMetric(c.validatorMissedBlocksTotal, prometheus.CounterValue, c.missedBlocksTotal[validatorAddr], c.cfg.ChainID, validatorAddr)
 	}
+	c.mu.Unlock()
 	
 	// 전체 통계 계산 (첫 번째 validator 기준, 비활성 시 0으로 설정)
 	if len(c.cfg.Validators) > 0 {
@@ -576,21 +1548,7 @@ func (c *UnifiedCollector) collectCosmosMetrics(ctx context.Context, ch chan<- p
 			maxConsecutiveMissed = stats.maxConsecutiveMissed
 			
 			// 첫 번째 validator의 active status 확인
-			validatorActive := 0.0
-			if currentHeight, err := strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64); err == nil {
-				if block, err := c.client.GetBlock(int(currentHeight)); err == nil {
-					for _, sig := range block.Result.Block.LastCommit.Signatures {
-						if sig.ValidatorAddress == firstValidator {
-							if sig.BlockIDFlag == 4 {
-								validatorActive = 1.0
-							} else if sig.BlockIDFlag == 5 {
-								validatorActive = 0.0
-							}
-							break
-						}
-					}
-				}
-			}
+			validatorActive := c.latestBlockSigned(ctx, firstValidator, latestHeight)
 			
 			// 비활성 validator의 경우 missed blocks를 0으로 설정
 			if validatorActive == 0.0 {
@@ -601,12 +1559,12 @@ func (c *UnifiedCollector) collectCosmosMetrics(ctx context.Context, ch chan<- p
 	}
 	
 	// Tenderduty metrics
-	height := int64(0)
-	if h, err := strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64); err == nil {
-		height = h
+	tdUp := 1.0
+	if latestHeight == 0 {
+		tdUp = 0
 	}
-	ch <- prometheus.MustNewConstMetric(c.tdUp, prometheus.GaugeValue, 1, c.cfg.ChainID)
-	ch <- prometheus.MustNewConstMetric(c.tdNodeHeight, prometheus.GaugeValue, float64(height), c.cfg.ChainID)
+	ch <- prometheus.MustNewConstMetric(c.tdUp, prometheus.GaugeValue, tdUp, c.cfg.ChainID)
+	ch <- prometheus.MustNewConstMetric(c.tdNodeHeight, prometheus.GaugeValue, float64(latestHeight), c.cfg.ChainID)
 	ch <- prometheus.MustNewConstMetric(c.tdBlocksBehind, prometheus.GaugeValue, 0, c.cfg.ChainID)
 	ch <- prometheus.MustNewConstMetric(c.tdSignedBlocks, prometheus.GaugeValue, float64(signedBlocks), c.cfg.ChainID)
 	ch <- prometheus.MustNewConstMetric(c.tdMissedBlocks, prometheus.GaugeValue, float64(missedBlocks), c.cfg.ChainID)
@@ -615,67 +1573,106 @@ func (c *UnifiedCollector) collectCosmosMetrics(ctx context.Context, ch chan<- p
 	ch <- prometheus.MustNewConstMetric(c.tdValidatorJailed, prometheus.GaugeValue, 0, c.cfg.ChainID)
 	ch <- prometheus.MustNewConstMetric(c.tdTimeSinceLastBlock, prometheus.GaugeValue, 0, c.cfg.ChainID)
 	
-	// 각 validator별 개별 메트릭 생성 - 실제 API 호출로 데이터 수집
-	// 먼저 모든 밸리데이터 정보를 가져옴
-	validators, err := c.client.GetValidators()
-	if err != nil {
-		c.logger.Error("Failed to get validators", "error", err)
-		return err
+	// 각 validator별 개별 메트릭 생성 - validators 조회 실패 시 validator 메트릭만 건너뜀
+	if validatorsErr != nil {
+		if c.recordFetchError("validators", validatorsErr) {
+			collectErr = validatorsErr
+		}
+	} else {
+		c.collectValidatorMetrics(ctx, ch, validators, validatorStats, proposerBlocks, latestHeight, stakingDenomInfo, bondedTokens)
+		c.collectUnbondingMetrics(ctx, ch, validators, stakingDenomInfo)
+	}
+
+	return collectErr
+}
+
+// collectValidatorMetrics emits per-validator metrics for the configured validators
+func (c *UnifiedCollector) collectValidatorMetrics(ctx context.Context, ch chan<- prometheus.Metric, validators *rpc.ValidatorsResponse, validatorStats map[string]validatorBlockStats, proposerBlocks int64, latestHeight int64, stakingDenomInfo denomInfo, bondedTokens string) {
+	client := c.client.WithContext(ctx)
+	// Signing info를 consensus address(HEX) 기준 맵으로 저장
+	signingInfoMap := make(map[string]rpc.SigningInfo)
+	if signingInfos, err := client.GetSigningInfos(); err == nil {
+		for _, info := range signingInfos.Info {
+			if hexAddr, err := util.ConsensusAddressToHex(info.Address); err == nil {
+				signingInfoMap[hexAddr] = info
+			}
+		}
+	} else {
+		c.recordFetchError("signing_infos", err)
+	}
+
+	// Slashing window (uptime / jail 계산용)
+	var signedBlocksWindow, minSignedPerWindow float64
+	if slashingParams, err := client.GetSlashingParams(); err == nil {
+		if window, err := util.ParseIntParam(slashingParams.Params.SignedBlocksWindow); err == nil {
+			signedBlocksWindow = float64(window)
+		}
+		minSignedPerWindow, _ = util.ParseDecimalParam(slashingParams.Params.MinSignedPerWindow)
+	}
+
+	// Consensus voting power (Tendermint /validators)
+	votingPowerMap := make(map[string]float64)
+	totalVotingPower := 0.0
+	if consensusValidators, err := client.GetConsensusValidators(int(latestHeight)); err == nil {
+		for _, validator := range consensusValidators {
+			if power, err := strconv.ParseFloat(validator.VotingPower, 64); err == nil {
+				votingPowerMap[strings.ToUpper(validator.Address)] = power
+				totalVotingPower += power
+			}
+		}
+	} else {
+		c.recordFetchError("consensus_validators", err)
 	}
 
 	// 밸리데이터 정보를 맵으로 저장
-	validatorInfoMap := make(map[string]struct {
-		Moniker          string
-		Tokens           string
-		DelegatorShares  string
-		CommissionRate   string
-		Status           string
-		Jailed           bool
-		ConsensusAddress string
-	})
+	validatorInfoMap := make(map[string]validatorInfo)
 
 	for _, validator := range validators.Validators {
-		validatorInfoMap[validator.ConsensusAddress] = struct {
-			Moniker          string
-			Tokens           string
-			DelegatorShares  string
-			CommissionRate   string
-			Status           string
-			Jailed           bool
-			ConsensusAddress string
-		}{
+		consensusAddress := c.validatorConsensusAddress(validator.ConsensusAddress, validator.ConsensusPubkey)
+		if hexAddr, err := util.NormalizeConsensusAddress(consensusAddress); err == nil {
+			consensusAddress = hexAddr
+		}
+		validatorInfoMap[consensusAddress] = validatorInfo{
+			OperatorAddress:  validator.OperatorAddress,
 			Moniker:          validator.Description.Moniker,
 			Tokens:           validator.Tokens,
 			DelegatorShares:  validator.DelegatorShares,
 			CommissionRate:   validator.Commission.CommissionRates.Rate,
+			CommissionMaxRate:       validator.Commission.CommissionRates.MaxRate,
+			CommissionMaxChangeRate: validator.Commission.CommissionRates.MaxChangeRate,
+			CommissionUpdateTime:    validator.Commission.UpdateTime,
 			Status:           validator.Status,
 			Jailed:           validator.Jailed,
-			ConsensusAddress: validator.ConsensusAddress,
+			ConsensusAddress: consensusAddress,
 		}
 	}
 
+	// 설정된 주소 중 validator set에 없는 주소 (오타 등) 확인
+	var unmatched []string
+	for _, validatorAddr := range c.cfg.Validators {
+		if _, exists := validatorInfoMap[c.consensusHex(validatorAddr)]; !exists {
+			unmatched = append(unmatched, validatorAddr)
+		}
+	}
+	if len(unmatched) > 0 {
+		c.unmatchedWarnOnce.Do(func() {
+			c.logger.Warn("Configured validators not found in the validator set", "chain_id", c.cfg.ChainID, "addresses", unmatched)
+		})
+	}
+	ch <- prometheus.MustNewConstMetric(c.configuredValidators, prometheus.GaugeValue, float64(len(c.cfg.Validators)), c.cfg.ChainID)
+	ch <- prometheus.MustNewConstMetric(c.configuredValidatorsMatched, prometheus.GaugeValue, float64(len(c.cfg.Validators)-len(unmatched)), c.cfg.ChainID)
+
 	for validatorAddr, stats := range validatorStats {
 		// Validator active status (block_id_flag 기반)
-		validatorActive := 0.0
-		if currentHeight, err := strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64); err == nil {
-			if block, err := c.client.GetBlock(int(currentHeight)); err == nil {
-				for _, sig := range block.Result.Block.LastCommit.Signatures {
-					if sig.ValidatorAddress == validatorAddr {
-						if sig.BlockIDFlag == 4 {
-							validatorActive = 1.0
-						} else if sig.BlockIDFlag == 5 {
-							validatorActive = 0.0
-						}
-						break
-					}
-				}
-			}
-		}
+		validatorActive := c.latestBlockSigned(ctx, validatorAddr, latestHeight)
 		
-		// 비활성 validator의 경우 서명/미스블럭을 0으로 설정
-		missedBlocks := stats.missedBlocks
-		if validatorActive == 0.0 {
-			missedBlocks = 0
+		// Missed blocks: signing info의 missed_blocks_counter 우선, 없으면 스캔 윈도우 기준
+		missedBlocks := float64(stats.missedBlocks)
+		signingInfo, hasSigningInfo := signingInfoMap[c.consensusHex(validatorAddr)]
+		if hasSigningInfo {
+			if missedCounter, err := strconv.ParseFloat(signingInfo.MissedBlocksCounter, 64); err == nil {
+				missedBlocks = missedCounter
+			}
 		}
 		
 		// 밸리데이터 정보 가져오기
@@ -685,28 +1682,61 @@ func (c *UnifiedCollector) collectCosmosMetrics(ctx context.Context, ch chan<- p
 		var commissionRate string = "0"
 		var validatorStatus string = "UNBONDED"
 		var jailed bool = false
+		var operatorAddress string
+		var commissionMaxRate, commissionMaxChangeRate, commissionUpdateTime string
 		
-		if info, exists := validatorInfoMap[validatorAddr]; exists {
+		if info, exists := validatorInfoMap[c.consensusHex(validatorAddr)]; exists {
+			operatorAddress = info.OperatorAddress
+			commissionMaxRate = info.CommissionMaxRate
+			commissionMaxChangeRate = info.CommissionMaxChangeRate
+			commissionUpdateTime = info.CommissionUpdateTime
 			moniker = info.Moniker
 			tokens = info.Tokens
 			delegatorShares = info.DelegatorShares
 			commissionRate = info.CommissionRate
 			validatorStatus = info.Status
 			jailed = info.Jailed
+		} else if lastMoniker, ok := c.validatorMoniker(validatorAddr); ok {
+			// staking 목록에 없으면 마지막으로 알려진 moniker 유지 (label 변경 방지)
+			moniker = lastMoniker
 		}
 		
 		// Missed blocks 메트릭
-		ch <- prometheus.MustNewConstMetric(c.validatorMissedBlocks, prometheus.GaugeValue, float64(missedBlocks), c.cfg.ChainID, validatorAddr, moniker)
+		ch <- prometheus.MustNewConstMetric(c.validatorMissedBlocks, prometheus.GaugeValue, missedBlocks, c.cfg.ChainID, validatorAddr, moniker)
 		ch <- prometheus.MustNewConstMetric(c.validatorActive, prometheus.GaugeValue, validatorActive, c.cfg.ChainID, validatorAddr, moniker)
+
+		// Active set 여부: consensus validator set 기준, 조회 실패 시 bonded status로 판단
+		inActiveSet := 0.0
+		if len(votingPowerMap) > 0 {
+			if _, exists := votingPowerMap[c.consensusHex(validatorAddr)]; exists {
+				inActiveSet = 1
+			}
+		} else if validatorStatus == "BOND_STATUS_BONDED" {
+			inActiveSet = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.validatorInActiveSet, prometheus.GaugeValue, inActiveSet, c.cfg.ChainID, validatorAddr, moniker)
+
+		c.mu.Lock()
+		proposedTotal := c.proposedBlocksTotal[validatorAddr]
+		c.mu.Unlock()
+		ch <- prometheus.MustNewConstMetric(c.validatorBlocksProposedTotal, prometheus.CounterValue, proposedTotal, c.cfg.ChainID, validatorAddr, moniker)
 		
 		// Validator 토큰 및 위임량
-		if tokensInt, err := strconv.ParseInt(tokens, 10, 64); err == nil {
-			tokensFloat := convertFromBaseUnit(tokensInt, c.cfg.TokenDecimals)
-			ch <- prometheus.MustNewConstMetric(c.validatorTokens, prometheus.GaugeValue, tokensFloat, c.cfg.ChainID, validatorAddr, moniker, "0G")
+		if tokensFloat, err := util.ScaleAmount(tokens, stakingDenomInfo.decimals); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.validatorTokens, prometheus.GaugeValue, tokensFloat, c.cfg.ChainID, validatorAddr, moniker, stakingDenomInfo.display)
+
+			// 이전 수집 대비 변화량 (첫 수집은 건너뜀)
+			if delta, ok := c.updateTokensChange(validatorAddr, tokensFloat); ok {
+				ch <- prometheus.MustNewConstMetric(c.validatorTokensChange, prometheus.GaugeValue, delta, c.cfg.ChainID, validatorAddr, moniker, stakingDenomInfo.display)
+			}
+		}
+
+		// 전체 bonded tokens 대비 stake 비중 (staking pool 조회 실패 시 생략)
+		if share, ok := stakeShare(tokens, bondedTokens); ok {
+			ch <- prometheus.MustNewConstMetric(c.validatorStakeShare, prometheus.GaugeValue, share, c.cfg.ChainID, validatorAddr, moniker)
 		}
 		
-		if delegatorSharesFloat, err := strconv.ParseFloat(delegatorShares, 64); err == nil {
-			delegatorSharesConverted := convertFromBaseUnitFloat(delegatorSharesFloat, c.cfg.TokenDecimals)
+		if delegatorSharesConverted, err := util.ScaleAmount(delegatorShares, stakingDenomInfo.decimals); err == nil {
 			ch <- prometheus.MustNewConstMetric(c.validatorDelegatorShares, prometheus.GaugeValue, delegatorSharesConverted, c.cfg.ChainID, validatorAddr, moniker)
 		}
 		
@@ -714,26 +1744,40 @@ func (c *UnifiedCollector) collectCosmosMetrics(ctx context.Context, ch chan<- p
 		if commissionRateFloat, err := strconv.ParseFloat(commissionRate, 64); err == nil {
 			ch <- prometheus.MustNewConstMetric(c.validatorCommissionRate, prometheus.GaugeValue, commissionRateFloat, c.cfg.ChainID, validatorAddr, moniker)
 		}
+		if maxRate, err := strconv.ParseFloat(commissionMaxRate, 64); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.validatorCommissionMaxRate, prometheus.GaugeValue, maxRate, c.cfg.ChainID, validatorAddr, moniker)
+		}
+		if maxChangeRate, err := strconv.ParseFloat(commissionMaxChangeRate, 64); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.validatorCommissionMaxChangeRate, prometheus.GaugeValue, maxChangeRate, c.cfg.ChainID, validatorAddr, moniker)
+		}
+		if updateTime, err := util.ParseBlockTime(commissionUpdateTime); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.validatorCommissionUpdateTime, prometheus.GaugeValue, float64(updateTime.Unix()), c.cfg.ChainID, validatorAddr, moniker)
+		}
 		
 		// Commission 및 Rewards (실제 API 호출)
-		if commission, err := c.client.GetValidatorCommission(validatorAddr); err == nil {
+		if commission, err := client.GetValidatorCommission(validatorAddr); err == nil {
 			for _, comm := range commission.Commission.Commission {
-				if amount, err := strconv.ParseInt(comm.Amount, 10, 64); err == nil {
-					amountFloat := convertFromBaseUnit(amount, c.cfg.TokenDecimals)
-					ch <- prometheus.MustNewConstMetric(c.validatorCommission, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, validatorAddr, moniker, comm.Denom)
+				info := c.denomInfo(comm.Denom)
+				if amountFloat, err := util.ScaleAmount(comm.Amount, info.decimals); err == nil {
+					ch <- prometheus.MustNewConstMetric(c.validatorCommission, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, validatorAddr, moniker, info.display)
 				}
 			}
 		}
 		
-		if rewards, err := c.client.GetValidatorRewards(validatorAddr); err == nil {
+		if rewards, err := client.GetValidatorRewards(validatorAddr); err == nil {
 			for _, reward := range rewards.Rewards.Rewards {
-				if amount, err := strconv.ParseInt(reward.Amount, 10, 64); err == nil {
-					amountFloat := convertFromBaseUnit(amount, c.cfg.TokenDecimals)
-					ch <- prometheus.MustNewConstMetric(c.validatorRewards, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, validatorAddr, moniker, reward.Denom)
+				info := c.denomInfo(reward.Denom)
+				if amountFloat, err := util.ScaleAmount(reward.Amount, info.decimals); err == nil {
+					ch <- prometheus.MustNewConstMetric(c.validatorRewards, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, validatorAddr, moniker, info.display)
 				}
 			}
 		}
 		
+		// Self-delegation rewards (operator 계정이 자기 validator에 위임한 보상)
+		if operatorAddress != "" {
+			c.collectSelfDelegationRewards(ctx, ch, validatorAddr, moniker, operatorAddress)
+		}
+		
 		// Status 및 Jailed
 		var statusValue float64
 		switch validatorStatus {
@@ -754,22 +1798,91 @@ func (c *UnifiedCollector) collectCosmosMetrics(ctx context.Context, ch chan<- p
 		
 		ch <- prometheus.MustNewConstMetric(c.validatorRank, prometheus.GaugeValue, 0, c.cfg.ChainID, validatorAddr, moniker)
 		ch <- prometheus.MustNewConstMetric(c.validatorStatus, prometheus.GaugeValue, statusValue, c.cfg.ChainID, validatorAddr, moniker)
+
+		// Bonded since (bonded 상태로 처음 관측된 시각, 이탈 시 리셋)
+		if bondedSince := c.updateBondedSince(validatorAddr, moniker, validatorStatus == "BOND_STATUS_BONDED"); !bondedSince.IsZero() {
+			ch <- prometheus.MustNewConstMetric(c.validatorBondedSince, prometheus.GaugeValue, float64(bondedSince.Unix()), c.cfg.ChainID, validatorAddr, moniker)
+		}
 		ch <- prometheus.MustNewConstMetric(c.validatorJailedDesc, prometheus.GaugeValue, jailedValue, c.cfg.ChainID, validatorAddr, moniker)
+
+		// Voting power
+		if power, exists := votingPowerMap[c.consensusHex(validatorAddr)]; exists {
+			ch <- prometheus.MustNewConstMetric(c.validatorVotingPower, prometheus.GaugeValue, power, c.cfg.ChainID, validatorAddr, moniker)
+			if totalVotingPower > 0 {
+				ch <- prometheus.MustNewConstMetric(c.validatorVotingPowerPercent, prometheus.GaugeValue, power/totalVotingPower*100, c.cfg.ChainID, validatorAddr, moniker)
+			}
+
+			// 스캔 윈도우의 기대 proposal 수 (proposer 선출은 voting power 비례), 현재 validator set 기준 근사치
+			if totalVotingPower > 0 && proposerBlocks > 0 {
+				expected := float64(proposerBlocks) * power / totalVotingPower
+				ch <- prometheus.MustNewConstMetric(c.validatorExpectedProposals, prometheus.GaugeValue, expected, c.cfg.ChainID, validatorAddr, moniker)
+				ch <- prometheus.MustNewConstMetric(c.validatorActualProposals, prometheus.GaugeValue, float64(stats.proposals), c.cfg.ChainID, validatorAddr, moniker)
+				if expected > 0 {
+					ch <- prometheus.MustNewConstMetric(c.validatorProposalRatio, prometheus.GaugeValue, float64(stats.proposals)/expected, c.cfg.ChainID, validatorAddr, moniker)
+				}
+			}
+		}
+
+		// Signing info (tombstoned, jailed_until, start_height)
+		if hasSigningInfo {
+			info := signingInfo
+			tombstonedValue := 0.0
+			if info.Tombstoned {
+				tombstonedValue = 1
+			}
+			ch <- prometheus.MustNewConstMetric(c.validatorTombstoned, prometheus.GaugeValue, tombstonedValue, c.cfg.ChainID, validatorAddr, moniker)
+
+			if jailedUntil, err := util.ParseBlockTime(info.JailedUntil); err == nil {
+				ch <- prometheus.MustNewConstMetric(c.validatorJailedUntil, prometheus.GaugeValue, float64(jailedUntil.Unix()), c.cfg.ChainID, validatorAddr, moniker)
+			}
+
+			if startHeight, err := strconv.ParseInt(info.StartHeight, 10, 64); err == nil {
+				ch <- prometheus.MustNewConstMetric(c.validatorSigningStartHeight, prometheus.GaugeValue, float64(startHeight), c.cfg.ChainID, validatorAddr, moniker)
+			}
+
+			// Uptime / blocks until jail (slashing window 기준)
+			if missedCounter, err := strconv.ParseFloat(info.MissedBlocksCounter, 64); err == nil && signedBlocksWindow > 0 {
+				uptime := (signedBlocksWindow - missedCounter) / signedBlocksWindow
+				ch <- prometheus.MustNewConstMetric(c.validatorUptimeRatio, prometheus.GaugeValue, uptime, c.cfg.ChainID, validatorAddr, moniker)
+
+				allowedMisses := jailThreshold(signedBlocksWindow, minSignedPerWindow)
+				blocksUntilJail := math.Max(allowedMisses-missedCounter, 0)
+				ch <- prometheus.MustNewConstMetric(c.validatorBlocksUntilJail, prometheus.GaugeValue, blocksUntilJail, c.cfg.ChainID, validatorAddr, moniker)
+
+				// 연속 miss 기준 jail 위험도
+				if allowedMisses > 0 {
+					ch <- prometheus.MustNewConstMetric(c.validatorJailThreshold, prometheus.GaugeValue, allowedMisses, c.cfg.ChainID, validatorAddr, moniker)
+					jailRisk := float64(c.currentConsecutiveMissed(validatorAddr, stats)) / allowedMisses
+					ch <- prometheus.MustNewConstMetric(c.validatorJailRisk, prometheus.GaugeValue, jailRisk, c.cfg.ChainID, validatorAddr, moniker)
+				}
+			}
+		}
+
+		// Composite health score
+		c.collectValidatorHealth(ch, validatorAddr, moniker, stats, inActiveSet == 1, jailed, hasSigningInfo && signingInfo.Tombstoned)
 	}
-	
-	// 전체 proposal 수 계산 (첫 번째 validator 기준)
-	if len(c.cfg.Validators) > 0 {
-		firstValidator := c.cfg.Validators[0]
-		if stats, exists := validatorStats[firstValidator]; exists {
-			ch <- prometheus.MustNewConstMetric(c.consensusProposalChain, prometheus.GaugeValue, float64(stats.proposals), c.cfg.ChainID)
+}
+
+// currentConsecutiveMissed returns the live consecutive miss count from the block tracker, or the miss
+// streak ending at the newest scanned block when the tracker is disabled
+func (c *UnifiedCollector) currentConsecutiveMissed(validatorAddr string, stats validatorBlockStats) int {
+	if c.blockTracker != nil {
+		if missed, ok := c.blockTracker.ConsecutiveMissed()[validatorAddr]; ok {
+			return missed
 		}
 	}
+	return stats.trailingMissed
+}
 
-	return nil
+// jailThreshold returns the number of blocks a validator may miss in the slashing window before it is
+// jailed: floor(signed_blocks_window * (1 - min_signed_per_window))
+func jailThreshold(signedBlocksWindow, minSignedPerWindow float64) float64 {
+	// 0.1 같은 10진 소수가 float로 표현되며 생기는 오차로 정수 경계 아래로 내려가지 않도록 보정
+	return math.Floor(signedBlocksWindow*(1-minSignedPerWindow) + 1e-9)
 }
 
 // collectEthereumMetrics collects metrics from Ethereum JSON-RPC
-func (c *UnifiedCollector) collectEthereumMetrics(ch chan<- prometheus.Metric) error {
+func (c *UnifiedCollector) collectEthereumMetrics(ctx context.Context, ch chan<- prometheus.Metric) error {
 	// Only collect Ethereum metrics for 0G Galileo Testnet
 	if c.cfg.ChainID != "0g-galileo-testnet" {
 		return nil
@@ -779,84 +1892,532 @@ func (c *UnifiedCollector) collectEthereumMetrics(ch chan<- prometheus.Metric) e
 	var ethClient *util.EthereumClient
 	if c.ethereumConfig != nil && c.ethereumConfig.JWTSecret != "" {
 		ethClient = util.NewEthereumClientWithJWT(c.ethereumConfig.RPCURL, c.ethereumConfig.JWTSecret)
-		c.logger.Info("Using Ethereum RPC with JWT authentication")
+		c.logger.Debug("Using Ethereum RPC with JWT authentication")
 			} else {
 		ethClient = util.NewEthereumClient(c.ethereumConfig.RPCURL)
-		c.logger.Warn("Using Ethereum RPC without JWT authentication")
+		c.logger.Debug("Using Ethereum RPC without JWT authentication")
+	}
+	// ethereum.tls (mTLS / private CA)는 JWT와 함께 사용 가능
+	if c.ethHTTPClient != nil {
+		ethClient.Client = c.ethHTTPClient
 	}
+	ethClient = ethClient.WithContext(ctx)
 
 	// Ethereum block number
 	if blockNumber, err := ethClient.GetBlockNumber(); err == nil {
-		if blockNum, err := strconv.ParseInt(blockNumber[2:], 16, 64); err == nil {
+		if blockNum, err := util.ParseHexQuantity(blockNumber); err == nil {
 			ch <- prometheus.MustNewConstMetric(c.ethBlockNumber, prometheus.GaugeValue, float64(blockNum), c.cfg.ChainID)
 		}
 	} else {
 		c.logger.Error("Failed to get Ethereum block number", "error", err)
 	}
 
-	// Staking contract status
-	stakingContract := c.ethereumConfig.StakingContract
-	if _, err := ethClient.GetBalance(stakingContract); err == nil {
-		ch <- prometheus.MustNewConstMetric(c.ethStakingContract, prometheus.GaugeValue, 1, c.cfg.ChainID, stakingContract)
-	} else {
-		ch <- prometheus.MustNewConstMetric(c.ethStakingContract, prometheus.GaugeValue, 0, c.cfg.ChainID, stakingContract)
-		c.logger.Error("Failed to get staking contract status", "error", err)
+	// Balance 단위 변환 (기본 18 decimals = ether)
+	balanceDecimals := 18
+	if c.ethereumConfig.BalanceDecimals != nil {
+		balanceDecimals = *c.ethereumConfig.BalanceDecimals
 	}
 
-	// Ethereum addresses balance
+	// Ethereum addresses balance (같은 주소의 validator balance는 중복 series가 되므로 건너뜀)
+	balanceAddresses := make(map[string]bool)
 	for _, ethAddr := range c.ethereumConfig.EthereumAddresses {
+		balanceAddresses[strings.ToLower(ethAddr.Address)] = true
 		if balance, err := ethClient.GetBalance(ethAddr.Address); err == nil {
-			if bal, err := strconv.ParseInt(balance[2:], 16, 64); err == nil {
-				ch <- prometheus.MustNewConstMetric(c.ethValidatorBalance, prometheus.GaugeValue, float64(bal), c.cfg.ChainID, ethAddr.Address, "unknown")
+			if bal, err := convertHexFromBaseUnit(balance, balanceDecimals); err == nil {
+				ch <- prometheus.MustNewConstMetric(c.ethValidatorBalance, prometheus.GaugeValue, bal, c.cfg.ChainID, ethAddr.Address, ethAddr.Name)
 			}
 					} else {
 			c.logger.Error("Failed to get Ethereum address balance", "address", ethAddr.Address, "error", err)
 		}
 	}
 
-	// Contract-based metrics (these may fail due to incorrect function selectors)
+	// Contract-based metrics, per configured contract (these may fail due to incorrect function selectors)
+	contracts := c.ethereumContracts()
+	for _, contract := range contracts {
+		c.collectEthereumContractMetrics(ch, ethClient.WithContract(contract.Address), contract, balanceDecimals)
+	}
+
+	// 설정된 validator pubkey는 첫 번째 contract (staking)에서 조회
+	if len(c.ethereumConfig.Validators) > 0 {
+		c.collectEthereumValidatorMetrics(ch, ethClient.WithContract(contracts[0].Address), balanceDecimals, balanceAddresses)
+	}
+
+	return nil
+}
+
+// collectEthereumValidatorMetrics resolves each configured validator pubkey on the staking contract and emits its
+// registration status and balance; a failing validator is logged and skipped. The balance of an address already in
+// balanceAddresses (lowercase, e.g. listed in ethereum_addresses) is not emitted again.
+func (c *UnifiedCollector) collectEthereumValidatorMetrics(ch chan<- prometheus.Metric, ethClient *util.EthereumClient, balanceDecimals int, balanceAddresses map[string]bool) {
+	for _, validator := range c.ethereumConfig.Validators {
+		address, err := ethClient.ComputeValidatorAddress(validator.Pubkey)
+		if err != nil {
+			c.logger.Error("Failed to compute validator address", "name", validator.Name, "pubkey", validator.Pubkey, "error", err)
+			continue
+		}
+
+		registered := 0.0
+		if registeredAddress, err := ethClient.GetValidatorByPubkey(validator.Pubkey); err == nil {
+			if registeredAddress != zeroAddress {
+				registered = 1
+			}
+		} else {
+			c.logger.Error("Failed to get validator by pubkey", "name", validator.Name, "pubkey", validator.Pubkey, "error", err)
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.ethValidatorStatus, prometheus.GaugeValue, registered, c.cfg.ChainID, validator.Name, validator.Pubkey, address)
+
+		if balanceAddresses[strings.ToLower(address)] {
+			continue
+		}
+		balanceAddresses[strings.ToLower(address)] = true
+		if balance, err := ethClient.GetBalance(address); err == nil {
+			if bal, err := convertHexFromBaseUnit(balance, balanceDecimals); err == nil {
+				ch <- prometheus.MustNewConstMetric(c.ethValidatorBalance, prometheus.GaugeValue, bal, c.cfg.ChainID, address, validator.Name)
+			}
+		} else {
+			c.logger.Error("Failed to get validator balance", "name", validator.Name, "address", address, "error", err)
+		}
+	}
+}
+
+// zeroAddress is returned by the staking contract for unregistered pubkeys
+const zeroAddress = "0x0000000000000000000000000000000000000000"
+
+// ethereumContracts returns the configured contracts; the legacy staking_contract (or the default 0G
+// staking contract when nothing is configured) is included as "staking"
+func (c *UnifiedCollector) ethereumContracts() []config.EthereumContract {
+	var contracts []config.EthereumContract
+	if c.ethereumConfig.StakingContract != "" {
+		contracts = append(contracts, config.EthereumContract{Name: "staking", Address: c.ethereumConfig.StakingContract})
+	}
+	contracts = append(contracts, c.ethereumConfig.Contracts...)
+	if len(contracts) == 0 {
+		contracts = append(contracts, config.EthereumContract{Name: "staking", Address: util.DefaultStakingContract})
+	}
+	return contracts
+}
+
+// collectEthereumContractMetrics reads the state of a single named contract
+func (c *UnifiedCollector) collectEthereumContractMetrics(ch chan<- prometheus.Metric, ethClient *util.EthereumClient, contract config.EthereumContract, balanceDecimals int) {
+	// Contract status
+	if _, err := ethClient.GetBalance(contract.Address); err == nil {
+		ch <- prometheus.MustNewConstMetric(c.ethStakingContract, prometheus.GaugeValue, 1, c.cfg.ChainID, contract.Name, contract.Address)
+	} else {
+		ch <- prometheus.MustNewConstMetric(c.ethStakingContract, prometheus.GaugeValue, 0, c.cfg.ChainID, contract.Name, contract.Address)
+		c.logger.Error("Failed to get contract status", "contract", contract.Name, "error", err)
+	}
+
 	if totalValidators, err := ethClient.GetTotalValidators(); err == nil {
-		ch <- prometheus.MustNewConstMetric(c.ethTotalValidators, prometheus.GaugeValue, float64(totalValidators), c.cfg.ChainID)
-		c.logger.Info("Retrieved total validators", "count", totalValidators)
-				} else {
-		c.logger.Error("Failed to get total validators", "error", err)
+		ch <- prometheus.MustNewConstMetric(c.ethTotalValidators, prometheus.GaugeValue, float64(totalValidators), c.cfg.ChainID, contract.Name)
+		c.logger.Debug("Retrieved total validators", "contract", contract.Name, "count", totalValidators)
+	} else {
+		c.logger.Error("Failed to get total validators", "contract", contract.Name, "error", err)
 	}
 
 	if activeValidators, err := ethClient.GetActiveValidators(); err == nil {
-		ch <- prometheus.MustNewConstMetric(c.ethActiveValidators, prometheus.GaugeValue, float64(activeValidators), c.cfg.ChainID)
-		c.logger.Info("Retrieved active validators", "count", activeValidators)
-			} else {
-		c.logger.Error("Failed to get active validators", "error", err)
+		ch <- prometheus.MustNewConstMetric(c.ethActiveValidators, prometheus.GaugeValue, float64(activeValidators), c.cfg.ChainID, contract.Name)
+		c.logger.Debug("Retrieved active validators", "contract", contract.Name, "count", activeValidators)
+	} else {
+		c.logger.Error("Failed to get active validators", "contract", contract.Name, "error", err)
 	}
 
 	if stakingPool, err := ethClient.GetStakingPool(); err == nil {
-		if poolBalance, err := strconv.ParseInt(stakingPool[2:], 16, 64); err == nil {
-			ch <- prometheus.MustNewConstMetric(c.ethStakingPool, prometheus.GaugeValue, float64(poolBalance), c.cfg.ChainID)
-			c.logger.Info("Retrieved staking pool", "balance", poolBalance)
+		if poolBalance, err := convertHexFromBaseUnit(stakingPool, balanceDecimals); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.ethStakingPool, prometheus.GaugeValue, poolBalance, c.cfg.ChainID, contract.Name)
+			c.logger.Debug("Retrieved staking pool", "contract", contract.Name, "balance", poolBalance)
 		}
-					} else {
-		c.logger.Error("Failed to get staking pool", "error", err)
+	} else {
+		c.logger.Error("Failed to get staking pool", "contract", contract.Name, "error", err)
 	}
 
 	if validatorCount, err := ethClient.GetValidatorCount(); err == nil {
-		ch <- prometheus.MustNewConstMetric(c.ethValidatorCount, prometheus.GaugeValue, float64(validatorCount), c.cfg.ChainID)
-		c.logger.Info("Retrieved validator count", "count", validatorCount)
+		ch <- prometheus.MustNewConstMetric(c.ethValidatorCount, prometheus.GaugeValue, float64(validatorCount), c.cfg.ChainID, contract.Name)
+		c.logger.Debug("Retrieved validator count", "contract", contract.Name, "count", validatorCount)
 	} else {
-		c.logger.Error("Failed to get validator count", "error", err)
+		c.logger.Error("Failed to get validator count", "contract", contract.Name, "error", err)
 	}
 
 	if maxValidators, err := ethClient.GetMaxValidatorCount(); err == nil {
-		ch <- prometheus.MustNewConstMetric(c.ethMaxValidators, prometheus.GaugeValue, float64(maxValidators), c.cfg.ChainID)
-		c.logger.Info("Retrieved max validators", "max", maxValidators)
+		ch <- prometheus.MustNewConstMetric(c.ethMaxValidators, prometheus.GaugeValue, float64(maxValidators), c.cfg.ChainID, contract.Name)
+		c.logger.Debug("Retrieved max validators", "contract", contract.Name, "max", maxValidators)
 	} else {
-		c.logger.Error("Failed to get max validators", "error", err)
+		c.logger.Error("Failed to get max validators", "contract", contract.Name, "error", err)
 	}
 
-	return nil
+	if validators, err := ethClient.GetValidatorsList(); err == nil {
+		for i, address := range validators {
+			ch <- prometheus.MustNewConstMetric(c.ethValidatorRegistered, prometheus.GaugeValue, 1, c.cfg.ChainID, contract.Name, address, strconv.Itoa(i))
+		}
+	} else {
+		c.logger.Error("Failed to get validators list", "contract", contract.Name, "error", err)
+	}
+}
+
+// collectSelfDelegationRewards emits the pending rewards of the validator operator's own delegation
+func (c *UnifiedCollector) collectSelfDelegationRewards(ctx context.Context, ch chan<- prometheus.Metric, validatorAddr, moniker, operatorAddress string) {
+	client := c.client.WithContext(ctx)
+	validatorPrefix := c.cfg.ValidatorPrefix
+	if validatorPrefix == "" && c.cfg.AccountPrefix != "" {
+		validatorPrefix = c.cfg.AccountPrefix + "valoper"
+	}
+	if validatorPrefix == "" || c.cfg.AccountPrefix == "" {
+		return
+	}
+
+	accountAddress, err := util.ConvertAddress(operatorAddress, validatorPrefix, c.cfg.AccountPrefix)
+	if err != nil {
+		c.logger.Error("Failed to convert operator address", "address", operatorAddress, "error", err)
+		return
+	}
+
+	rewards, err := client.GetDelegationRewards(accountAddress, operatorAddress)
+	if err != nil {
+		c.logger.Error("Failed to get self-delegation rewards", "address", operatorAddress, "error", err)
+		return
+	}
+
+	for denom, amount := range sumCoinsByDenom(rewards.Rewards) {
+		info := c.denomInfo(denom)
+		amountFloat := util.ScaleBigAmount(amount, info.decimals)
+		ch <- prometheus.MustNewConstMetric(c.validatorSelfDelegationRewards, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, validatorAddr, moniker, info.display)
+	}
+}
+
+// collectGovernanceDepositMetrics emits deposit progress for proposals in deposit period against the min_deposit
+// param (skipped when no proposal is in deposit period or the chain does not serve the deposit params)
+func (c *UnifiedCollector) collectGovernanceDepositMetrics(ctx context.Context, ch chan<- prometheus.Metric, proposals *rpc.GovernanceProposalsResponse) {
+	client := c.client.WithContext(ctx)
+	inDepositPeriod := false
+	for _, proposal := range proposals.Proposals {
+		if proposal.Status != "PROPOSAL_STATUS_DEPOSIT_PERIOD" {
+			continue
+		}
+		inDepositPeriod = true
+
+		for denom, amount := range sumCoinsByDenom(proposal.TotalDeposit) {
+			info := c.denomInfo(denom)
+			ch <- prometheus.MustNewConstMetric(c.govProposalTotalDeposit, prometheus.GaugeValue, util.ScaleBigAmount(amount, info.decimals), c.cfg.ChainID, proposal.ProposalID, info.display)
+		}
+		if depositEnd, err := util.ParseBlockTime(proposal.DepositEndTime); err == nil {
+			ch <- prometheus.MustNewConstMetric(c.govProposalDepositEnd, prometheus.GaugeValue, math.Max(time.Until(depositEnd).Seconds(), 0), c.cfg.ChainID, proposal.ProposalID)
+		}
+	}
+	if !inDepositPeriod {
+		return
+	}
+
+	depositParams, err := client.GetGovDepositParams()
+	if err != nil {
+		c.recordFetchError("gov_deposit_params", err)
+		return
+	}
+	for denom, amount := range sumCoinsByDenom(depositParams.DepositParams.MinDeposit) {
+		info := c.denomInfo(denom)
+		ch <- prometheus.MustNewConstMetric(c.govMinDeposit, prometheus.GaugeValue, util.ScaleBigAmount(amount, info.decimals), c.cfg.ChainID, info.display)
+	}
+}
+
+// collectGovernanceTallyMetrics emits turnout and quorum progress for proposals in voting period
+func (c *UnifiedCollector) collectGovernanceTallyMetrics(ctx context.Context, ch chan<- prometheus.Metric, proposals *rpc.GovernanceProposalsResponse, bondedTokens float64) {
+	client := c.client.WithContext(ctx)
+	if bondedTokens <= 0 {
+		return
+	}
+
+	var quorum float64
+	quorumKnown := false
+	for _, proposal := range proposals.Proposals {
+		if proposal.Status != "PROPOSAL_STATUS_VOTING_PERIOD" {
+			continue
+		}
+
+		// tally params는 voting period proposal이 있을 때만 조회
+		if !quorumKnown {
+			tallyParams, err := client.GetGovTallyParams()
+			if err != nil {
+				c.logger.Error("Failed to get gov tally params", "error", err)
+				return
+			}
+			if quorum, err = strconv.ParseFloat(tallyParams.TallyParams.Quorum, 64); err != nil {
+				return
+			}
+			quorumKnown = true
+		}
+
+		tally, err := client.GetProposalTally(proposal.ProposalID)
+		if err != nil {
+			c.logger.Error("Failed to get proposal tally", "proposal_id", proposal.ProposalID, "error", err)
+			continue
+		}
+
+		totalVotes := 0.0
+		for _, count := range []string{tally.Tally.YesCount, tally.Tally.AbstainCount, tally.Tally.NoCount, tally.Tally.NoWithVetoCount} {
+			if votes, err := strconv.ParseFloat(count, 64); err == nil {
+				totalVotes += votes
+			}
+		}
+
+		turnout := totalVotes / bondedTokens
+		quorumReached := 0.0
+		if turnout >= quorum {
+			quorumReached = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.govProposalTurnoutRatio, prometheus.GaugeValue, turnout, c.cfg.ChainID, proposal.ProposalID)
+		ch <- prometheus.MustNewConstMetric(c.govProposalQuorumReached, prometheus.GaugeValue, quorumReached, c.cfg.ChainID, proposal.ProposalID)
+	}
+}
+
+// collectIBCClientMetrics emits expiry and status metrics for each tendermint IBC light client
+func (c *UnifiedCollector) collectIBCClientMetrics(ctx context.Context, ch chan<- prometheus.Metric) {
+	rpcClient := c.client.WithContext(ctx)
+	clientStates, err := rpcClient.GetIBCClientStates()
+	if err != nil {
+		c.recordFetchError("ibc_client_states", err)
+		return
+	}
+
+	for _, client := range clientStates.ClientStates {
+		state := client.ClientState
+		if state.TrustingPeriod == "" {
+			continue
+		}
+
+		trustingPeriod, err := time.ParseDuration(state.TrustingPeriod)
+		if err != nil {
+			continue
+		}
+
+		consensusState, err := rpcClient.GetIBCConsensusState(client.ClientID, state.LatestHeight)
+		if err != nil {
+			c.logger.Error("Failed to get IBC consensus state", "client_id", client.ClientID, "error", err)
+			continue
+		}
+
+		lastUpdate, err := time.Parse(time.RFC3339Nano, consensusState.ConsensusState.Timestamp)
+		if err != nil {
+			continue
+		}
+
+		expirySeconds := time.Until(lastUpdate.Add(trustingPeriod)).Seconds()
+
+		var statusValue float64
+		switch {
+		case state.FrozenHeight.RevisionHeight != "" && state.FrozenHeight.RevisionHeight != "0":
+			statusValue = 3
+		case expirySeconds <= 0:
+			statusValue = 2
+		default:
+			statusValue = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.ibcClientExpiry, prometheus.GaugeValue, expirySeconds, c.cfg.ChainID, client.ClientID, state.ChainID)
+		ch <- prometheus.MustNewConstMetric(c.ibcClientStatus, prometheus.GaugeValue, statusValue, c.cfg.ChainID, client.ClientID, state.ChainID)
+	}
+}
+
+// collectIBCEscrowMetrics emits the escrow account balance of each configured ICS-20 channel per denom
+func (c *UnifiedCollector) collectIBCEscrowMetrics(ctx context.Context, ch chan<- prometheus.Metric) {
+	client := c.client.WithContext(ctx)
+	for _, channel := range c.cfg.IBCEscrowChannels {
+		port := channel.Port
+		if port == "" {
+			port = "transfer"
+		}
+
+		escrow, err := client.GetIBCEscrowAddress(channel.Channel, port)
+		if err != nil {
+			if !c.recordFetchError("ibc_escrow_address", err) {
+				// transfer 모듈 없음 - 나머지 채널도 건너뜀
+				return
+			}
+			continue
+		}
+
+		balance, err := client.GetWalletBalance(escrow.EscrowAddress)
+		if err != nil {
+			c.recordFetchError("ibc_escrow_balance", err)
+			continue
+		}
+
+		for denom, amount := range sumCoinsByDenom(balance.Balances) {
+			info := c.denomInfo(denom)
+			ch <- prometheus.MustNewConstMetric(c.ibcEscrowBalance, prometheus.GaugeValue, util.ScaleBigAmount(amount, info.decimals), c.cfg.ChainID, port, channel.Channel, info.display)
+		}
+	}
+}
+
+// stakeShare returns tokens / bondedTokens computed on integers (NaN when bondedTokens is zero)
+func stakeShare(tokens, bondedTokens string) (float64, bool) {
+	validatorTokens, ok := new(big.Int).SetString(tokens, 10)
+	if !ok {
+		return 0, false
+	}
+	bonded, ok := new(big.Int).SetString(bondedTokens, 10)
+	if !ok {
+		return 0, false
+	}
+	if bonded.Sign() == 0 {
+		return math.NaN(), true
+	}
+	share, _ := new(big.Rat).SetFrac(validatorTokens, bonded).Float64()
+	return share, true
+}
+
+// sumCoinsByDenom sums raw coin amounts (integer or decimal strings) per denom
+func sumCoinsByDenom(coins []rpc.Coin) map[string]*big.Float {
+	totals := make(map[string]*big.Float)
+	for _, coin := range coins {
+		if amount, err := util.ParseAmount(coin.Amount); err == nil {
+			if total, exists := totals[coin.Denom]; exists {
+				total.Add(total, amount)
+			} else {
+				totals[coin.Denom] = amount
+			}
+		}
+	}
+	return totals
+}
+
+// countValidatorsByStatus splits the staking validator set into bonded (active) and non-bonded (inactive) validators
+func countValidatorsByStatus(validators *rpc.ValidatorsResponse) (active, inactive int) {
+	for _, validator := range validators.Validators {
+		if validator.Status == "BOND_STATUS_BONDED" {
+			active++
+		} else {
+			inactive++
+		}
+	}
+	return active, inactive
+}
+
+// weightedCommission returns the token-weighted average commission rate of the validators, sum(rate * tokens) /
+// sum(tokens). Validators with unparsable rates or tokens are skipped; ok is false when no tokens are left
+func weightedCommission(validators *rpc.ValidatorsResponse) (float64, bool) {
+	weighted := new(big.Rat)
+	totalTokens := new(big.Int)
+	for _, validator := range validators.Validators {
+		rate, ok := new(big.Rat).SetString(validator.Commission.CommissionRates.Rate)
+		if !ok {
+			continue
+		}
+		tokens, ok := new(big.Int).SetString(validator.Tokens, 10)
+		if !ok {
+			continue
+		}
+		weighted.Add(weighted, rate.Mul(rate, new(big.Rat).SetInt(tokens)))
+		totalTokens.Add(totalTokens, tokens)
+	}
+	if totalTokens.Sign() == 0 {
+		return 0, false
+	}
+	avg, _ := weighted.Quo(weighted, new(big.Rat).SetInt(totalTokens)).Float64()
+	return avg, true
+}
+
+// medianCommission returns the median commission rate of the validators (ok is false without parsable rates)
+func medianCommission(validators *rpc.ValidatorsResponse) (float64, bool) {
+	rates := make([]float64, 0, len(validators.Validators))
+	for _, validator := range validators.Validators {
+		if rate, err := strconv.ParseFloat(validator.Commission.CommissionRates.Rate, 64); err == nil {
+			rates = append(rates, rate)
+		}
+	}
+	if len(rates) == 0 {
+		return 0, false
+	}
+	sort.Float64s(rates)
+	middle := len(rates) / 2
+	if len(rates)%2 == 0 {
+		return (rates[middle-1] + rates[middle]) / 2, true
+	}
+	return rates[middle], true
+}
+
+// updateBondedSince tracks when a validator was first observed in BOND_STATUS_BONDED and returns that time
+// (zero while the validator is not bonded)
+func (c *UnifiedCollector) updateBondedSince(addr, moniker string, bonded bool) time.Time {
+	c.validatorStatesMu.Lock()
+	defer c.validatorStatesMu.Unlock()
+
+	state := c.validatorStateLocked(addr)
+	state.moniker = moniker
+	state.active = bonded
+
+	if !bonded {
+		state.bondedSince = time.Time{}
+	} else if state.bondedSince.IsZero() {
+		state.bondedSince = time.Now()
+	}
+	return state.bondedSince
+}
+
+// updateTokensChange stores the validator's bonded tokens and returns the delta to the previous collection
+// (ok is false on the first collection)
+func (c *UnifiedCollector) updateTokensChange(addr string, tokens float64) (delta float64, ok bool) {
+	c.validatorStatesMu.Lock()
+	defer c.validatorStatesMu.Unlock()
+
+	state := c.validatorStateLocked(addr)
+
+	delta, ok = tokens-state.previousTokens, state.hasPrevTokens
+	state.previousTokens = tokens
+	state.hasPrevTokens = true
+	return delta, ok
+}
+
+// validatorStateLocked returns the state of addr, creating it if needed; validatorStatesMu must be held for writing
+func (c *UnifiedCollector) validatorStateLocked(addr string) *validatorState {
+	state, exists := c.validatorStates[addr]
+	if !exists {
+		state = &validatorState{consensusAddress: addr}
+		c.validatorStates[addr] = state
+	}
+	return state
+}
+
+// latestBlockSigned returns 1 if the validator signed the last commit of the block at latestHeight. An empty last
+// commit keeps the previous result instead of reporting the validator as inactive
+func (c *UnifiedCollector) latestBlockSigned(ctx context.Context, validatorAddr string, latestHeight int64) float64 {
+	client := c.client.WithContext(ctx)
+	if latestHeight <= 0 {
+		return 0
+	}
+	block, err := client.GetBlock(int(latestHeight))
+	if err != nil {
+		return 0
+	}
+
+	c.validatorStatesMu.Lock()
+	defer c.validatorStatesMu.Unlock()
+	state := c.validatorStateLocked(validatorAddr)
+	if hasLastCommit(block) {
+		state.signedLatest = signatureFlags(block)[c.consensusHex(validatorAddr)] == 4
+	}
+	if state.signedLatest {
+		return 1
+	}
+	return 0
+}
+
+// validatorMoniker returns the last known moniker of a validator from validatorStates
+func (c *UnifiedCollector) validatorMoniker(addr string) (string, bool) {
+	c.validatorStatesMu.RLock()
+	defer c.validatorStatesMu.RUnlock()
+
+	state, exists := c.validatorStates[addr]
+	if !exists || state.moniker == "" {
+		return "", false
+	}
+	return state.moniker, true
 }
 
 // updateValidatorMonikers updates validator moniker information
 func (c *UnifiedCollector) updateValidatorMonikers(monikers map[string]string) {
+	c.validatorStatesMu.Lock()
+	defer c.validatorStatesMu.Unlock()
+
 	for addr, moniker := range monikers {
 		if _, exists := c.validatorStates[addr]; !exists {
 			c.validatorStates[addr] = &validatorState{
@@ -0,0 +1,47 @@
+package collector
+
+import (
+	"testing"
+
+	"zerog-exporter/rpc"
+)
+
+func TestCountValidatorsByStatus(t *testing.T) {
+	validators := &rpc.ValidatorsResponse{}
+	for _, status := range []string{
+		"BOND_STATUS_BONDED",
+		"BOND_STATUS_BONDED",
+		"BOND_STATUS_BONDED",
+		"BOND_STATUS_UNBONDING",
+		"BOND_STATUS_UNBONDED",
+		"BOND_STATUS_UNBONDED",
+	} {
+		validators.Validators = append(validators.Validators, rpc.Validator{Status: status})
+	}
+
+	active, inactive := countValidatorsByStatus(validators)
+	if active != 3 || inactive != 3 {
+		t.Errorf("countValidatorsByStatus = %d active / %d inactive, want 3 / 3", active, inactive)
+	}
+}
+
+func TestCollectValidatorSetCounts(t *testing.T) {
+	// bonded 3 (그중 1은 서명 누락 - 여전히 active), unbonding (jailed) 1, unbonded 1
+	validators := fakeValidators(5)
+	validators[1].missing = true
+	validators[3].status = "BOND_STATUS_UNBONDING"
+	validators[3].jailed = true
+	validators[4].status = "BOND_STATUS_UNBONDED"
+
+	samples := collectSamples(t, newFakeCollector(t, validators))
+
+	if got := sampleValue(t, samples, "cosmos_validators_total", nil); got != 5 {
+		t.Errorf("cosmos_validators_total = %v, want 5", got)
+	}
+	if got := sampleValue(t, samples, "cosmos_validators_active", nil); got != 3 {
+		t.Errorf("cosmos_validators_active = %v, want 3", got)
+	}
+	if got := sampleValue(t, samples, "cosmos_validators_inactive", nil); got != 2 {
+		t.Errorf("cosmos_validators_inactive = %v, want 2", got)
+	}
+}
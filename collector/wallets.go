@@ -0,0 +1,160 @@
+package collector
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"time"
+
+	"zerog-exporter/rpc"
+	"zerog-exporter/util"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxConcurrentWallets bounds the number of wallets queried at the same time
+const maxConcurrentWallets = 8
+
+// walletData holds the REST responses of one wallet (nil when the query failed or was skipped)
+type walletData struct {
+	balance     *rpc.WalletBalanceResponse
+	delegations *rpc.WalletDelegationsResponse
+	rewards     *rpc.WalletRewardsResponse
+	unbonding   *rpc.WalletUnbondingResponse
+}
+
+// fetchWalletData queries the balance, delegations, rewards and unbonding of every configured wallet, one
+// goroutine per wallet (at most maxConcurrentWallets at a time). Queries not yet started when ctx is done are skipped.
+func (c *UnifiedCollector) fetchWalletData(ctx context.Context) []walletData {
+	client := c.client.WithContext(ctx)
+	data := make([]walletData, len(c.cfg.Wallets))
+
+	var g errgroup.Group
+	g.SetLimit(maxConcurrentWallets)
+	for i, wallet := range c.cfg.Wallets {
+		i, address := i, wallet.Address
+		g.Go(func() error {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if balance, err := client.GetWalletBalance(address); err == nil {
+				data[i].balance = balance
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+			if delegations, err := client.GetWalletDelegations(address); err == nil {
+				data[i].delegations = delegations
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+			if rewards, err := client.GetWalletRewards(address); err == nil {
+				data[i].rewards = rewards
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+			if unbonding, err := client.GetWalletUnbonding(address); err == nil {
+				data[i].unbonding = unbonding
+			}
+			return nil
+		})
+	}
+	g.Wait()
+	return data
+}
+
+// collectWalletMetrics emits the wallet balance / delegation / reward / unbonding metrics.
+// 같은 denom 항목이 여러 개일 수 있으므로 denom 별로 합산 후 emit
+func (c *UnifiedCollector) collectWalletMetrics(ctx context.Context, ch chan<- prometheus.Metric, stakingDenom string, stakingDenomInfo denomInfo) {
+	if len(c.cfg.Wallets) == 0 {
+		return
+	}
+
+	data := c.fetchWalletData(ctx)
+	for i, wallet := range c.cfg.Wallets {
+		walletTotal := 0.0
+
+		// Wallet Balance
+		if balance := data[i].balance; balance != nil {
+			for denom, amount := range sumCoinsByDenom(balance.Balances) {
+				info := c.denomInfo(denom)
+				amountFloat := util.ScaleBigAmount(amount, info.decimals)
+				ch <- prometheus.MustNewConstMetric(c.walletBalance, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, wallet.Address, wallet.Name, info.display)
+				if denom == stakingDenom {
+					walletTotal += amountFloat
+				}
+			}
+		}
+
+		// Wallet Delegations
+		if delegations := data[i].delegations; delegations != nil {
+			var coins []rpc.Coin
+			for _, del := range delegations.DelegationResponses {
+				coins = append(coins, rpc.Coin{Amount: del.Balance.Amount, Denom: del.Balance.Denom})
+			}
+			for denom, amount := range sumCoinsByDenom(coins) {
+				info := c.denomInfo(denom)
+				amountFloat := util.ScaleBigAmount(amount, info.decimals)
+				ch <- prometheus.MustNewConstMetric(c.walletDelegations, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, wallet.Address, wallet.Name, info.display)
+				if denom == stakingDenom {
+					walletTotal += amountFloat
+				}
+			}
+		}
+
+		// Wallet Rewards
+		if rewards := data[i].rewards; rewards != nil {
+			var coins []rpc.Coin
+			for _, reward := range rewards.Rewards {
+				coins = append(coins, reward.Reward...)
+
+				// validator별 분리 (cardinality 때문에 opt-in)
+				if c.cfg.WalletRewardsByValidator {
+					for denom, amount := range sumCoinsByDenom(reward.Reward) {
+						info := c.denomInfo(denom)
+						ch <- prometheus.MustNewConstMetric(c.walletRewardsByValidator, prometheus.GaugeValue, util.ScaleBigAmount(amount, info.decimals), c.cfg.ChainID, wallet.Address, wallet.Name, reward.ValidatorAddress, info.display)
+					}
+				}
+			}
+			for denom, amount := range sumCoinsByDenom(coins) {
+				info := c.denomInfo(denom)
+				amountFloat := util.ScaleBigAmount(amount, info.decimals)
+				ch <- prometheus.MustNewConstMetric(c.walletRewards, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, wallet.Address, wallet.Name, info.display)
+				if denom == stakingDenom {
+					walletTotal += amountFloat
+				}
+			}
+		}
+
+		// Wallet Unbonding (항상 staking denom)
+		if unbonding := data[i].unbonding; unbonding != nil {
+			unbondingTotal := new(big.Float)
+			for _, ub := range unbonding.UnbondingResponses {
+				// validator별 가장 먼저 끝나는 entry까지 남은 시간
+				remaining := -1.0
+				for _, entry := range ub.Entries {
+					if amount, err := util.ParseAmount(entry.Balance); err == nil {
+						unbondingTotal.Add(unbondingTotal, amount)
+					}
+					if completion, err := util.ParseBlockTime(entry.CompletionTime); err == nil {
+						seconds := math.Max(time.Until(completion).Seconds(), 0)
+						if remaining < 0 || seconds < remaining {
+							remaining = seconds
+						}
+					}
+				}
+				if remaining >= 0 {
+					ch <- prometheus.MustNewConstMetric(c.walletUnbondingCompletion, prometheus.GaugeValue, remaining, c.cfg.ChainID, wallet.Address, wallet.Name, ub.ValidatorAddress)
+				}
+			}
+			amountFloat := util.ScaleBigAmount(unbondingTotal, stakingDenomInfo.decimals)
+			ch <- prometheus.MustNewConstMetric(c.walletUnbonding, prometheus.GaugeValue, amountFloat, c.cfg.ChainID, wallet.Address, wallet.Name, stakingDenomInfo.display)
+			walletTotal += amountFloat
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.walletTotal, prometheus.GaugeValue, walletTotal, c.cfg.ChainID, wallet.Address, wallet.Name)
+	}
+}
@@ -0,0 +1,79 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"zerog-exporter/config"
+	"zerog-exporter/rpc"
+)
+
+// delegationEntry is one delegation_responses entry of the staking delegations endpoint
+func delegationEntry(delegator, validator, amount string) string {
+	return fmt.Sprintf(`{"delegation": {"delegator_address": "%s", "validator_address": "%s", "shares": "%s.000000000000000000"}, "balance": {"denom": "ua0gi", "amount": "%s"}}`,
+		delegator, validator, amount, amount)
+}
+
+func TestWalletDelegationsSummedAcrossPages(t *testing.T) {
+	const address = "0g1fvsfjw7ysythang73guuan4g48zzcqkmujnpu6"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/cosmos/staking/v1beta1/delegations/"+address, func(w http.ResponseWriter, r *http.Request) {
+		// 페이지당 2개, 총 5개 delegation
+		switch r.URL.Query().Get("pagination.key") {
+		case "":
+			fmt.Fprintf(w, `{"delegation_responses": [%s, %s], "pagination": {"next_key": "page-2", "total": "5"}}`,
+				delegationEntry(address, "0gvaloper1a", "1000000000000000000"),
+				delegationEntry(address, "0gvaloper1b", "2000000000000000000"))
+		case "page-2":
+			fmt.Fprintf(w, `{"delegation_responses": [%s, %s], "pagination": {"next_key": "page-3", "total": "0"}}`,
+				delegationEntry(address, "0gvaloper1c", "250000000000000000"),
+				delegationEntry(address, "0gvaloper1d", "250000000000000000"))
+		case "page-3":
+			fmt.Fprintf(w, `{"delegation_responses": [%s], "pagination": {"next_key": null, "total": "0"}}`,
+				delegationEntry(address, "0gvaloper1e", "500000000000000000"))
+		default:
+			http.Error(w, "unexpected pagination.key", http.StatusBadRequest)
+		}
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not implemented", http.StatusNotImplemented)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	cfg := &config.Chain{ChainID: "0g-fake-1", TokenDecimals: 18, Wallets: []config.Wallet{{Address: address, Name: "treasury"}}}
+	client := rpc.NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+	client.SetPageLimit(2)
+	c := NewUnifiedCollector(client, cfg, nil, nil, "")
+
+	ch := make(chan prometheus.Metric, 64)
+	c.collectWalletMetrics(context.Background(), ch, "ua0gi", c.denomInfo("ua0gi"))
+	close(ch)
+
+	var delegations, total []float64
+	for metric := range ch {
+		descriptor, ok := parseDesc(metric.Desc())
+		if !ok {
+			continue
+		}
+		_, value, _ := metricSample(metric)
+		switch descriptor.Name {
+		case "cosmos_wallet_delegations":
+			delegations = append(delegations, value)
+		case "cosmos_wallet_total":
+			total = append(total, value)
+		}
+	}
+	if len(delegations) != 1 || delegations[0] != 4 {
+		t.Errorf("cosmos_wallet_delegations = %v, want [4]", delegations)
+	}
+	if len(total) != 1 || total[0] != 4 {
+		t.Errorf("cosmos_wallet_total = %v, want [4]", total)
+	}
+}
@@ -0,0 +1,125 @@
+package collector
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// wsMinBackoff is the first reconnect delay after the websocket connection drops
+	wsMinBackoff = time.Second
+	// wsDefaultMaxBackoff caps the reconnect delay when block_tracking.websocket_max_backoff is not set
+	wsDefaultMaxBackoff = 60 * time.Second
+)
+
+// newBlockSubscription is the CometBFT JSON-RPC request subscribing to NewBlock events
+var newBlockSubscription = map[string]interface{}{
+	"jsonrpc": "2.0",
+	"method":  "subscribe",
+	"id":      1,
+	"params":  map[string]string{"query": "tm.event='NewBlock'"},
+}
+
+// runWebSocket processes new blocks as they are announced over the websocket, reconnecting with
+// exponential backoff and jitter on disconnect. While disconnected the tracker falls back to polling.
+func (t *blockTracker) runWebSocket(ctx context.Context) {
+	backoff := wsMinBackoff
+	for {
+		connected, err := t.subscribeNewBlocks(ctx)
+		t.setWebSocketConnected(false)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			backoff = wsMinBackoff
+		}
+
+		t.mu.Lock()
+		t.wsReconnects++
+		t.mu.Unlock()
+
+		// jitter: backoff/2 ~ backoff
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		t.logger.Warn("Websocket disconnected, polling until reconnect", "url", t.wsURL, "retry_in", wait, "error", err)
+		if !t.pollFor(ctx, wait) {
+			return
+		}
+
+		backoff *= 2
+		if backoff > t.wsMaxBackoff {
+			backoff = t.wsMaxBackoff
+		}
+	}
+}
+
+// subscribeNewBlocks connects, subscribes to NewBlock events and polls on each event until the connection
+// fails or ctx is cancelled. It reports whether the subscription was established.
+func (t *blockTracker) subscribeNewBlocks(ctx context.Context) (bool, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, t.wsURL, nil)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	// ctx 취소 시 ReadMessage 블로킹 해제
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	if err := conn.WriteJSON(newBlockSubscription); err != nil {
+		return false, err
+	}
+
+	t.setWebSocketConnected(true)
+	t.logger.Info("Websocket subscribed to new blocks", "url", t.wsURL)
+
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return true, err
+		}
+		// 이벤트 payload 대신 REST로 블록을 가져와 폴링과 동일하게 처리
+		t.poll()
+	}
+}
+
+// pollFor polls at the tracker interval for the given duration; it returns false if ctx was cancelled
+func (t *blockTracker) pollFor(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		t.poll()
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		case <-ticker.C:
+		}
+	}
+}
+
+func (t *blockTracker) setWebSocketConnected(connected bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.wsConnected = connected
+}
+
+// WebSocketStatus returns whether the websocket subscription is connected and how often it reconnected
+func (t *blockTracker) WebSocketStatus() (connected bool, reconnects int) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.wsConnected, t.wsReconnects
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"gopkg.in/yaml.v2"
 	"io/ioutil"
 )
@@ -8,17 +9,42 @@ import (
 type Config struct {
 	ListenAddress   string         `yaml:"listen_address"`
 	MetricsInterval int            `yaml:"metrics_interval"`
+	CollectionTimeout int          `yaml:"collection_timeout"` // seconds, defaults to metrics_interval
+	MaxConcurrentChains int        `yaml:"max_concurrent_chains"`
 	BlockTracking   BlockTracking  `yaml:"block_tracking"`
 	Chains          []Chain        `yaml:"chains"`
 	Logging         Logging        `yaml:"logging"`
 	Prometheus      Prometheus     `yaml:"prometheus"`
 	Ethereum        Ethereum       `yaml:"ethereum"`
+	ExternalLabels  map[string]string `yaml:"external_labels"`
+	OpenMetrics     bool           `yaml:"open_metrics"`
+	ReadinessPolicy string         `yaml:"readiness_policy"`
+	SuccessRatioWindow int         `yaml:"success_ratio_window"`
+	TenderdutyCompat bool          `yaml:"tenderduty_compat"`
+	Pushgateway     Pushgateway    `yaml:"pushgateway"`
+}
+
+// Pushgateway pushes the gathered metrics to a Prometheus Pushgateway on an interval (enabled when url is set).
+// The /metrics endpoint keeps serving in push mode
+type Pushgateway struct {
+	URL            string            `yaml:"url"`
+	Job            string            `yaml:"job"`
+	GroupingLabels map[string]string `yaml:"grouping_labels"`
+	Interval       int               `yaml:"interval"` // seconds, defaults to metrics_interval
+	Retries        *int              `yaml:"retries"`  // extra attempts per push after a failure (0 = none, unset = 3)
 }
 
 type BlockTracking struct {
 	Enabled                 bool `yaml:"enabled"`
 	Interval               int  `yaml:"interval"`
 	MaxConsecutiveMissed  int  `yaml:"max_consecutive_missed"`
+	BlockTimeBuckets      []float64 `yaml:"block_time_buckets"`
+	AvgBlockTimeGauge     *bool `yaml:"avg_block_time_gauge"` // precomputed cosmos_avg_block_time (unset = true)
+	BlockTimeMaxAge       int  `yaml:"block_time_max_age"`
+	BlockTimeEMAAlpha     float64 `yaml:"block_time_ema_alpha"`
+	ScanWindow            int  `yaml:"scan_window"`
+	UseWebSocket          bool `yaml:"use_websocket"`
+	WebSocketMaxBackoff   int  `yaml:"websocket_max_backoff"`
 }
 
 type Chain struct {
@@ -26,6 +52,8 @@ type Chain struct {
 	Name             string   `yaml:"name"`
 	RPC              string   `yaml:"rpc"`
 	API              string   `yaml:"api"`
+	ArchiveRPC       string   `yaml:"archive_rpc"`
+	ExtraRPCs        []string `yaml:"extra_rpcs"`
 	WebSocket        string   `yaml:"websocket"`
 	AccountPrefix    string   `yaml:"account_prefix"`
 	ValidatorPrefix  string   `yaml:"validator_prefix"`
@@ -34,11 +62,63 @@ type Chain struct {
 	TokenDisplay     string   `yaml:"token_display"`
 	TokenDecimals    int      `yaml:"token_decimals"`
 	AutoDetect       bool     `yaml:"auto_detect"`
+	SDKVersion       string   `yaml:"sdk_version"`
+	PageLimit        int      `yaml:"page_limit"`
+	MaxValidators    int      `yaml:"max_validators"`
+	MaxResponseBytes int64    `yaml:"max_response_bytes"`
+	ConditionalRequests bool  `yaml:"conditional_requests"`
+	ConsensusStateMetrics bool `yaml:"consensus_state_metrics"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+	CAFile           string   `yaml:"ca_file"`
+	InflationPath    string   `yaml:"inflation_path"`
+	AnnualProvisionsPath string `yaml:"annual_provisions_path"`
+	RESTPaths        map[string]string `yaml:"rest_paths"`
+	IgnoreDenoms     []string `yaml:"ignore_denoms"`
+	IncludeDenoms    []string `yaml:"include_denoms"`
+	DenomDisplay     map[string]string `yaml:"denom_display"`
 	Validators       []string `yaml:"validators"`
 	Wallets          []Wallet `yaml:"wallets"`
+	WalletRewardsByValidator bool `yaml:"wallet_rewards_by_validator"`
+	UnbondingScope   string   `yaml:"unbonding_scope"`
+	IBCEscrowChannels []IBCChannel `yaml:"ibc_escrow_channels"`
+	ValidatorHealth  ValidatorHealth `yaml:"validator_health"`
+	CircuitBreaker   CircuitBreaker `yaml:"circuit_breaker"`
+	RefreshIntervals RefreshIntervals `yaml:"refresh_intervals"`
 	Peers            []string `yaml:"peers"`
 }
 
+// RefreshIntervals are the seconds slowly changing data is cached before it is queried again (0 = default).
+// Height, blocks and signing data are queried on every collection.
+type RefreshIntervals struct {
+	Params     int `yaml:"params"`
+	Validators int `yaml:"validators"`
+	Supply     int `yaml:"supply"`
+}
+
+// CircuitBreaker fast-fails requests to an RPC / REST endpoint after consecutive failures (failure_threshold < 0 disables it)
+type CircuitBreaker struct {
+	FailureThreshold int `yaml:"failure_threshold"`
+	Cooldown         int `yaml:"cooldown"` // seconds
+}
+
+type ValidatorHealth struct {
+	MinSigningRatio      float64       `yaml:"min_signing_ratio"`
+	MaxConsecutiveMissed int           `yaml:"max_consecutive_missed"`
+	Weights              HealthWeights `yaml:"weights"`
+}
+
+type HealthWeights struct {
+	Active            float64 `yaml:"active"`
+	Signing           float64 `yaml:"signing"`
+	ConsecutiveMissed float64 `yaml:"consecutive_missed"`
+	Tombstoned        float64 `yaml:"tombstoned"`
+}
+
+type IBCChannel struct {
+	Channel string `yaml:"channel"`
+	Port    string `yaml:"port"`
+}
+
 type Wallet struct {
 	Address string `yaml:"address"`
 	Name    string `yaml:"name"`
@@ -56,9 +136,32 @@ type Prometheus struct {
 
 type Ethereum struct {
 	RPCURL             string           `yaml:"rpc_url"`
-	JWTSecret          string           `yaml:"jwt_secret"`
+	JWTSecret          string           `yaml:"jwt_secret" sensitive:"true"`
 	StakingContract    string           `yaml:"staking_contract"`
+	Contracts          []EthereumContract `yaml:"contracts"`
+	BalanceDecimals    *int             `yaml:"balance_decimals"`
 	EthereumAddresses  []EthereumWallet `yaml:"ethereum_addresses"`
+	Validators         []EthereumValidator `yaml:"validators"`
+	TLS                EthereumTLS      `yaml:"tls"`
+}
+
+// EthereumTLS configures TLS for the Ethereum RPC: a private CA and / or a client certificate for mTLS.
+// It can be combined with jwt_secret
+type EthereumTLS struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	CAFile   string `yaml:"ca_file"`
+}
+
+// EthereumValidator is a validator of the EVM staking contract monitored by consensus pubkey
+type EthereumValidator struct {
+	Pubkey string `yaml:"pubkey"`
+	Name   string `yaml:"name"`
+}
+
+type EthereumContract struct {
+	Name    string `yaml:"name"`
+	Address string `yaml:"address"`
 }
 
 type EthereumWallet struct {
@@ -78,5 +181,46 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, err
 	}
 
+	for _, chain := range config.Chains {
+		if chain.MaxValidators < 0 || chain.MaxResponseBytes < 0 {
+			return nil, fmt.Errorf("chain %s: max_validators and max_response_bytes must not be negative", chain.ChainID)
+		}
+		if chain.PageLimit < 0 {
+			return nil, fmt.Errorf("chain %s: page_limit must be positive, got %d", chain.ChainID, chain.PageLimit)
+		}
+	}
+
+	for _, chain := range config.Chains {
+		switch chain.UnbondingScope {
+		case "", UnbondingScopeConfigured, UnbondingScopeAll, UnbondingScopeNone:
+		default:
+			return nil, fmt.Errorf("chain %s: unbonding_scope must be %q, %q or %q, got %q", chain.ChainID, UnbondingScopeConfigured, UnbondingScopeAll, UnbondingScopeNone, chain.UnbondingScope)
+		}
+	}
+
+	if config.CollectionTimeout < 0 {
+		return nil, fmt.Errorf("collection_timeout must not be negative, got %d", config.CollectionTimeout)
+	}
+
+	// 스캔 윈도우 블록은 매 수집마다 조회하므로 수집 timeout 안에 끝날 수 있는 범위로 제한
+	if config.BlockTracking.ScanWindow > MaxBlockScanWindow {
+		return nil, fmt.Errorf("block_tracking.scan_window must be at most %d, got %d", MaxBlockScanWindow, config.BlockTracking.ScanWindow)
+	}
+
+	if config.Pushgateway.Retries != nil && *config.Pushgateway.Retries < 0 {
+		return nil, fmt.Errorf("pushgateway.retries must not be negative, got %d", *config.Pushgateway.Retries)
+	}
+
+	if (config.Ethereum.TLS.CertFile == "") != (config.Ethereum.TLS.KeyFile == "") {
+		return nil, fmt.Errorf("ethereum.tls: cert_file and key_file must be set together")
+	}
+
+	switch config.ReadinessPolicy {
+	case "", ReadinessAny, ReadinessAll:
+	default:
+		return nil, fmt.Errorf("readiness_policy must be %q or %q, got %q", ReadinessAny, ReadinessAll, config.ReadinessPolicy)
+	}
+
+	config.applyDefaults()
 	return &config, nil
 }
\ No newline at end of file
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// loadYAML writes content to a temporary config file and loads it
+func loadYAML(t *testing.T, content string) (*Config, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return LoadConfig(path)
+}
+
+func TestPushgatewayRetries(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want int
+	}{
+		{name: "unset uses default", yaml: "pushgateway:\n  url: http://pushgateway:9091\n", want: DefaultPushRetries},
+		{name: "zero disables retries", yaml: "pushgateway:\n  url: http://pushgateway:9091\n  retries: 0\n", want: 0},
+		{name: "explicit value", yaml: "pushgateway:\n  url: http://pushgateway:9091\n  retries: 5\n", want: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := loadYAML(t, tt.yaml)
+			if err != nil {
+				t.Fatalf("LoadConfig: %v", err)
+			}
+			if cfg.Pushgateway.Retries == nil || *cfg.Pushgateway.Retries != tt.want {
+				t.Errorf("retries = %v, want %d", cfg.Pushgateway.Retries, tt.want)
+			}
+		})
+	}
+}
+
+func TestPushgatewayNegativeRetriesRejected(t *testing.T) {
+	_, err := loadYAML(t, "pushgateway:\n  url: http://pushgateway:9091\n  retries: -1\n")
+	if err == nil || !strings.Contains(err.Error(), "pushgateway.retries") {
+		t.Fatalf("LoadConfig error = %v, want a pushgateway.retries error", err)
+	}
+}
+
+func TestScanWindowUpperBound(t *testing.T) {
+	cfg, err := loadYAML(t, "block_tracking:\n  scan_window: 1000\n")
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.BlockTracking.ScanWindow != MaxBlockScanWindow {
+		t.Errorf("scan_window = %d, want %d", cfg.BlockTracking.ScanWindow, MaxBlockScanWindow)
+	}
+
+	_, err = loadYAML(t, "block_tracking:\n  scan_window: 5000\n")
+	if err == nil || !strings.Contains(err.Error(), "block_tracking.scan_window") {
+		t.Errorf("LoadConfig error = %v, want a block_tracking.scan_window error", err)
+	}
+}
+
+func TestCollectionTimeout(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+		want int
+	}{
+		{name: "unset without metrics_interval", yaml: "listen_address: \":26330\"\n", want: DefaultCollectionTimeout},
+		{name: "defaults to metrics_interval", yaml: "metrics_interval: 15\n", want: 15},
+		{name: "explicit value", yaml: "metrics_interval: 15\ncollection_timeout: 8\n", want: 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := loadYAML(t, tt.yaml)
+			if err != nil {
+				t.Fatalf("LoadConfig: %v", err)
+			}
+			if cfg.CollectionTimeout != tt.want {
+				t.Errorf("collection_timeout = %d, want %d", cfg.CollectionTimeout, tt.want)
+			}
+		})
+	}
+
+	if _, err := loadYAML(t, "collection_timeout: -1\n"); err == nil {
+		t.Error("LoadConfig accepted a negative collection_timeout")
+	}
+}
+
+func TestAvgBlockTimeGauge(t *testing.T) {
+	cfg, err := loadYAML(t, "block_tracking:\n  enabled: true\n")
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.BlockTracking.AvgBlockTimeGauge == nil || !*cfg.BlockTracking.AvgBlockTimeGauge {
+		t.Errorf("avg_block_time_gauge = %v, want true by default", cfg.BlockTracking.AvgBlockTimeGauge)
+	}
+
+	cfg, err = loadYAML(t, "block_tracking:\n  avg_block_time_gauge: false\n")
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg.BlockTracking.AvgBlockTimeGauge == nil || *cfg.BlockTracking.AvgBlockTimeGauge {
+		t.Errorf("avg_block_time_gauge = %v, want false", cfg.BlockTracking.AvgBlockTimeGauge)
+	}
+}
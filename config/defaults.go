@@ -0,0 +1,167 @@
+package config
+
+import (
+	"reflect"
+)
+
+// Defaults applied to unset (zero) config values
+const (
+	DefaultPageLimit             = 100
+	DefaultBlockTrackingInterval = 5
+	DefaultBlockScanWindow       = 100
+	MaxBlockScanWindow           = 1000
+	DefaultWebSocketMaxBackoff   = 60
+	DefaultBalanceDecimals       = 18
+	DefaultIBCPort               = "transfer"
+	DefaultCircuitFailures       = 5
+	DefaultCircuitCooldown       = 30
+	DefaultPushJob               = "zerog-exporter"
+	DefaultPushInterval          = 15
+	DefaultPushRetries           = 3
+	DefaultSuccessRatioWindow    = 60
+	DefaultCollectionTimeout     = 5
+)
+
+// Readiness policies: /ready fails only when every chain is failing (any) or as soon as one chain fails (all)
+const (
+	ReadinessAny = "any"
+	ReadinessAll = "all"
+)
+
+// Log formats: logging.format selects the slog handler (unknown values fall back to json with a warning)
+const (
+	LogFormatJSON = "json"
+	LogFormatText = "text"
+)
+
+// Unbonding scopes: validators whose unbonding delegations are queried for cosmos_validator_unbonding_total
+// (all validators also enables the chain-wide cosmos_unbonding_total)
+const (
+	UnbondingScopeConfigured = "configured"
+	UnbondingScopeAll        = "all"
+	UnbondingScopeNone       = "none"
+)
+
+// redactedValue replaces non-empty sensitive fields in Redacted
+const redactedValue = "<redacted>"
+
+// applyDefaults fills in unset values so the loaded config reflects what the exporter actually uses
+func (c *Config) applyDefaults() {
+	if c.BlockTracking.Interval <= 0 {
+		c.BlockTracking.Interval = DefaultBlockTrackingInterval
+	}
+	if c.BlockTracking.ScanWindow <= 0 {
+		c.BlockTracking.ScanWindow = DefaultBlockScanWindow
+	}
+	if c.BlockTracking.AvgBlockTimeGauge == nil {
+		enabled := true
+		c.BlockTracking.AvgBlockTimeGauge = &enabled
+	}
+	if c.BlockTracking.WebSocketMaxBackoff <= 0 {
+		c.BlockTracking.WebSocketMaxBackoff = DefaultWebSocketMaxBackoff
+	}
+	if c.CollectionTimeout == 0 {
+		c.CollectionTimeout = c.MetricsInterval
+		if c.CollectionTimeout <= 0 {
+			c.CollectionTimeout = DefaultCollectionTimeout
+		}
+	}
+	if c.Pushgateway.Job == "" {
+		c.Pushgateway.Job = DefaultPushJob
+	}
+	if c.Pushgateway.Interval <= 0 {
+		c.Pushgateway.Interval = c.MetricsInterval
+		if c.Pushgateway.Interval <= 0 {
+			c.Pushgateway.Interval = DefaultPushInterval
+		}
+	}
+	if c.Pushgateway.Retries == nil {
+		retries := DefaultPushRetries
+		c.Pushgateway.Retries = &retries
+	}
+	if c.Logging.Format == "" {
+		c.Logging.Format = LogFormatJSON
+	}
+	if c.SuccessRatioWindow <= 0 {
+		c.SuccessRatioWindow = DefaultSuccessRatioWindow
+	}
+	if c.ReadinessPolicy == "" {
+		c.ReadinessPolicy = ReadinessAny
+	}
+	if c.Ethereum.BalanceDecimals == nil {
+		decimals := DefaultBalanceDecimals
+		c.Ethereum.BalanceDecimals = &decimals
+	}
+
+	for i := range c.Chains {
+		chain := &c.Chains[i]
+		if chain.PageLimit == 0 {
+			chain.PageLimit = DefaultPageLimit
+		}
+		if chain.UnbondingScope == "" {
+			chain.UnbondingScope = UnbondingScopeConfigured
+		}
+		if chain.CircuitBreaker.FailureThreshold == 0 {
+			chain.CircuitBreaker.FailureThreshold = DefaultCircuitFailures
+		}
+		if chain.CircuitBreaker.Cooldown <= 0 {
+			chain.CircuitBreaker.Cooldown = DefaultCircuitCooldown
+		}
+		for j := range chain.IBCEscrowChannels {
+			if chain.IBCEscrowChannels[j].Port == "" {
+				chain.IBCEscrowChannels[j].Port = DefaultIBCPort
+			}
+		}
+	}
+}
+
+// Redacted returns a deep copy of the config with every non-empty string field tagged `sensitive:"true"` redacted
+func (c *Config) Redacted() *Config {
+	redacted := reflect.New(reflect.TypeOf(*c)).Elem()
+	copyRedacted(redacted, reflect.ValueOf(*c), false)
+	result := redacted.Interface().(Config)
+	return &result
+}
+
+// copyRedacted deep-copies src into dst, redacting sensitive strings
+func copyRedacted(dst, src reflect.Value, sensitive bool) {
+	switch src.Kind() {
+	case reflect.Struct:
+		for i := 0; i < src.NumField(); i++ {
+			field := src.Type().Field(i)
+			copyRedacted(dst.Field(i), src.Field(i), field.Tag.Get("sensitive") == "true")
+		}
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Len()))
+		for i := 0; i < src.Len(); i++ {
+			copyRedacted(dst.Index(i), src.Index(i), sensitive)
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.MakeMap(src.Type()))
+		for _, key := range src.MapKeys() {
+			value := reflect.New(src.Type().Elem()).Elem()
+			copyRedacted(value, src.MapIndex(key), sensitive)
+			dst.SetMapIndex(key, value)
+		}
+	case reflect.Ptr:
+		if src.IsNil() {
+			return
+		}
+		dst.Set(reflect.New(src.Type().Elem()))
+		copyRedacted(dst.Elem(), src.Elem(), sensitive)
+	case reflect.String:
+		if sensitive && src.String() != "" {
+			dst.SetString(redactedValue)
+		} else {
+			dst.SetString(src.String())
+		}
+	default:
+		dst.Set(src)
+	}
+}
@@ -1,14 +1,24 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/common/expfmt"
+	"gopkg.in/yaml.v2"
 
 	"zerog-exporter/config"
 	"zerog-exporter/collector"
@@ -16,6 +26,13 @@ import (
 )
 
 func main() {
+	once := flag.Bool("once", false, "Collect metrics from all chains once, print them to stdout and exit")
+	printConfig := flag.Bool("print-config", false, "Print the resolved config (defaults and auto-detection applied, secrets redacted) as YAML and exit")
+	listMetrics := flag.Bool("list-metrics", false, "Print the name, help and labels of every metric the exporter can emit as JSON and exit")
+	backfill := flag.String("backfill", "", "Process the signatures of the blocks in the height range from:to into the signed / missed block counters before collection starts")
+	backfillChain := flag.String("backfill-chain", "", "Chain ID to backfill (default: every configured chain)")
+	flag.Parse()
+
 	cfg, err := config.LoadConfig("config.yml")
 	if err != nil {
 		logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
@@ -23,6 +40,22 @@ func main() {
 		os.Exit(1)
 	}
 
+	var backfillFrom, backfillTo int64
+	if *backfill != "" {
+		backfillFrom, backfillTo, err = parseHeightRange(*backfill)
+		if err != nil {
+			slog.New(slog.NewJSONHandler(os.Stderr, nil)).Error("Invalid -backfill range", "error", err)
+			os.Exit(2)
+		}
+	}
+
+	if *printConfig {
+		os.Exit(runPrintConfig(cfg))
+	}
+	if *listMetrics {
+		os.Exit(runListMetrics(cfg))
+	}
+
 	var logLevel slog.Level
 	switch cfg.Logging.Level {
 	case "debug":
@@ -37,8 +70,24 @@ func main() {
 		logLevel = slog.LevelInfo
 	}
 
+	// -once 모드에서는 stdout을 메트릭 출력 전용으로 사용
+	logOutput := os.Stdout
+	if *once {
+		logOutput = os.Stderr
+	}
+
 	opts := &slog.HandlerOptions{Level: logLevel}
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, opts))
+	var logHandler slog.Handler
+	switch cfg.Logging.Format {
+	case config.LogFormatText:
+		logHandler = slog.NewTextHandler(logOutput, opts)
+	default:
+		logHandler = slog.NewJSONHandler(logOutput, opts)
+	}
+	logger := slog.New(logHandler)
+	if cfg.Logging.Format != config.LogFormatJSON && cfg.Logging.Format != config.LogFormatText {
+		logger.Warn("Unknown logging format, using json", "format", cfg.Logging.Format)
+	}
 
 	for i := range cfg.Chains {
 		chain := &cfg.Chains[i]
@@ -48,18 +97,129 @@ func main() {
 	}
 
 	registry := prometheus.NewRegistry()
+	// external_labels는 모든 메트릭에 const label로 붙음
+	registerer := prometheus.WrapRegistererWith(prometheus.Labels(cfg.ExternalLabels), registry)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 커스텀 registry라 Go runtime / process 메트릭을 직접 등록
+	registerer.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpc.RequestDuration,
+		pushFailures,
+	)
 
-	for _, chain := range cfg.Chains {
+	limiter := collector.NewConcurrencyLimiter(cfg.MaxConcurrentChains)
+
+	// Ethereum RPC TLS (client certificate / private CA) - 모든 chain collector가 공유
+	var ethHTTPClient *http.Client
+	if ethTLS := cfg.Ethereum.TLS; ethTLS.CertFile != "" || ethTLS.CAFile != "" {
+		ethHTTPClient, err = rpc.NewMTLSHTTPClient(false, ethTLS.CAFile, ethTLS.CertFile, ethTLS.KeyFile)
+		if err != nil {
+			logger.Error("Failed to configure Ethereum RPC TLS", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	var chainCollectors []*collector.UnifiedCollector
+	for i := range cfg.Chains {
+		chain := &cfg.Chains[i]
 		client := rpc.NewClient(chain.RPC, chain.API, chain.WebSocket)
-		unifiedCollector := collector.NewUnifiedCollector(client, &chain, &cfg.Ethereum, cfg.Prometheus.Server)
-		registry.MustRegister(unifiedCollector)
+		if chain.InsecureSkipVerify || chain.CAFile != "" {
+			if chain.InsecureSkipVerify {
+				logger.Warn("TLS certificate verification disabled", "chain_id", chain.ChainID)
+			}
+			httpClient, err := rpc.NewTLSHTTPClient(chain.InsecureSkipVerify, chain.CAFile)
+			if err != nil {
+				logger.Error("Failed to configure TLS", "chain_id", chain.ChainID, "error", err)
+				os.Exit(1)
+			}
+			client = rpc.NewClientWithHTTPClient(chain.RPC, chain.API, chain.WebSocket, httpClient)
+		}
+		client.SetPageLimit(chain.PageLimit)
+		client.SetMaxValidators(chain.MaxValidators)
+		client.SetMaxBodySize(chain.MaxResponseBytes)
+		client.SetMintPaths(chain.InflationPath, chain.AnnualProvisionsPath)
+		if err := client.SetModulePaths(chain.RESTPaths); err != nil {
+			logger.Warn("Ignoring rest_paths entries", "chain_id", chain.ChainID, "error", err)
+		}
+		client.SetConditionalRequests(chain.ConditionalRequests)
+		client.SetCacheTTLs(rpc.CacheTTLs{
+			Params:     time.Duration(chain.RefreshIntervals.Params) * time.Second,
+			Validators: time.Duration(chain.RefreshIntervals.Validators) * time.Second,
+			Supply:     time.Duration(chain.RefreshIntervals.Supply) * time.Second,
+		})
+		client.SetArchiveRPC(chain.ArchiveRPC)
+		client.SetCircuitBreaker(chain.CircuitBreaker.FailureThreshold, time.Duration(chain.CircuitBreaker.Cooldown)*time.Second)
+		unifiedCollector := collector.NewUnifiedCollector(client, chain, &cfg.Ethereum, &cfg.BlockTracking, cfg.Prometheus.Server)
+		unifiedCollector.SetLogger(logger)
+		unifiedCollector.SetConcurrencyLimiter(limiter)
+		unifiedCollector.SetSuccessRatioWindow(cfg.SuccessRatioWindow)
+		unifiedCollector.SetCollectionTimeout(time.Duration(cfg.CollectionTimeout) * time.Second)
+		if ethHTTPClient != nil {
+			unifiedCollector.SetEthereumHTTPClient(ethHTTPClient)
+		}
+		if cfg.OpenMetrics {
+			unifiedCollector.EnableExemplars()
+		}
+		if chain.SDKVersion == "" && chain.AutoDetect {
+			if err := unifiedCollector.DetectSDKVersion(); err != nil {
+				logger.Warn("Failed to detect Cosmos SDK version", "chain_id", chain.ChainID, "error", err)
+			}
+		}
+		for _, err := range unifiedCollector.ValidateConfiguredAddresses() {
+			logger.Warn("Invalid address in config", "chain_id", chain.ChainID, "error", err)
+		}
+		if err := unifiedCollector.LoadDenomMetadata(); err != nil {
+			logger.Warn("Failed to load denom metadata, using configured token settings", "chain_id", chain.ChainID, "error", err)
+		}
+		// 첫 scrape부터 평균 block time이 나오도록 최근 두 블록으로 초기화
+		if err := unifiedCollector.WarmUpBlockTime(); err != nil {
+			logger.Warn("Failed to warm up block time", "chain_id", chain.ChainID, "error", err)
+		}
+		// backfill은 백그라운드 수집 시작 전에 끝나야 중복 카운트가 없음
+		if *backfill != "" && (*backfillChain == "" || *backfillChain == chain.ChainID) {
+			logger.Info("Backfilling block signatures", "chain_id", chain.ChainID, "from", backfillFrom, "to", backfillTo)
+			processed, err := unifiedCollector.Backfill(ctx, backfillFrom, backfillTo)
+			if err != nil {
+				logger.Error("Backfill failed", "chain_id", chain.ChainID, "error", err)
+				os.Exit(1)
+			}
+			logger.Info("Backfill complete", "chain_id", chain.ChainID, "blocks", processed)
+		}
+
+		registerer.MustRegister(exportedCollector(unifiedCollector, cfg.TenderdutyCompat))
+		chainCollectors = append(chainCollectors, unifiedCollector)
+
+		if cfg.MetricsInterval > 0 && !*once {
+			logger.Info("Starting background collection", "chain_id", chain.ChainID, "interval", cfg.MetricsInterval)
+			go unifiedCollector.StartBackgroundCollection(ctx, time.Duration(cfg.MetricsInterval)*time.Second)
+		}
+
+		if cfg.BlockTracking.Enabled && !*once {
+			logger.Info("Starting block tracker", "chain_id", chain.ChainID, "interval", cfg.BlockTracking.Interval)
+			go unifiedCollector.StartBlockTracking(ctx)
+		}
+	}
+
+	if *once {
+		os.Exit(runOnce(registry, chainCollectors, logger))
+	}
+
+	// Pushgateway 모드: scrape 대신 (또는 함께) 주기적으로 push
+	if cfg.Pushgateway.URL != "" {
+		logger.Info("Starting Pushgateway push", "url", cfg.Pushgateway.URL, "job", cfg.Pushgateway.Job, "interval", cfg.Pushgateway.Interval)
+		go runPushLoop(ctx, registry, cfg.Pushgateway, logger)
 	}
 
-	http.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	http.Handle("/metrics", metricsHandler(registry, chainCollectors, cfg.ExternalLabels, cfg.OpenMetrics, cfg.TenderdutyCompat))
 	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
+	http.Handle("/ready", readyHandler(chainCollectors, cfg.ReadinessPolicy))
 
 	logger.Info("Starting server", "address", cfg.ListenAddress)
 	if err := http.ListenAndServe(cfg.ListenAddress, nil); err != nil {
@@ -72,4 +232,182 @@ func main() {
 	<-sigChan
 
 	logger.Info("Shutting down gracefully...")
-}
\ No newline at end of file
+}
+
+// exportedCollector returns the collector registered for a chain: the native collector, or its tenderduty
+// translation with tenderduty_compat (native 메트릭 대신 tenderduty 이름 / label로만 export)
+func exportedCollector(c *collector.UnifiedCollector, tenderdutyCompat bool) prometheus.Collector {
+	if tenderdutyCompat {
+		return collector.NewTenderdutyCollector(c)
+	}
+	return c
+}
+
+// metricsHandler serves all chains, or only the chain named by the "chain" query parameter
+// (multi-target pattern) from a temporary registry holding just that chain's collector
+func metricsHandler(registry *prometheus.Registry, chainCollectors []*collector.UnifiedCollector, externalLabels map[string]string, openMetrics, tenderdutyCompat bool) http.Handler {
+	opts := promhttp.HandlerOpts{EnableOpenMetrics: openMetrics}
+	all := promhttp.HandlerFor(registry, opts)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		chainID := r.URL.Query().Get("chain")
+		if chainID == "" {
+			all.ServeHTTP(w, r)
+			return
+		}
+
+		for _, c := range chainCollectors {
+			if c.ChainID() != chainID {
+				continue
+			}
+			target := prometheus.NewRegistry()
+			if err := prometheus.WrapRegistererWith(prometheus.Labels(externalLabels), target).Register(exportedCollector(c, tenderdutyCompat)); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			promhttp.HandlerFor(target, opts).ServeHTTP(w, r)
+			return
+		}
+
+		http.Error(w, "unknown chain: "+chainID, http.StatusNotFound)
+	})
+}
+
+// chainReadiness is the per-chain entry of the /ready response
+type chainReadiness struct {
+	Ready       bool       `json:"ready"`
+	LastSuccess *time.Time `json:"last_success"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// readyHandler reports per-chain readiness as JSON. It returns 503 when every chain is failing (policy "any")
+// or when any chain is failing (policy "all"); a chain is failing until its first successful collection.
+func readyHandler(chainCollectors []*collector.UnifiedCollector, policy string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		chains := make(map[string]chainReadiness, len(chainCollectors))
+		readyCount := 0
+		for _, c := range chainCollectors {
+			ready, lastSuccess, err := c.ReadyStatus()
+			status := chainReadiness{Ready: ready}
+			if !lastSuccess.IsZero() {
+				status.LastSuccess = &lastSuccess
+			}
+			if err != nil {
+				status.Error = err.Error()
+			}
+			if ready {
+				readyCount++
+			}
+			chains[c.ChainID()] = status
+		}
+
+		ready := readyCount > 0
+		if policy == config.ReadinessAll {
+			ready = readyCount == len(chainCollectors)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !ready {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"ready":  ready,
+			"policy": policy,
+			"chains": chains,
+		})
+	})
+}
+
+// runListMetrics prints the descriptors of every metric as JSON without contacting any node and returns the exit code
+func runListMetrics(cfg *config.Config) int {
+	// Describe는 노드에 접근하지 않으므로 빈 chain 설정으로 충분
+	unifiedCollector := collector.NewUnifiedCollector(rpc.NewClient("", "", ""), &config.Chain{}, &cfg.Ethereum, &cfg.BlockTracking, "")
+	descriptors := collector.DescribeMetrics(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpc.RequestDuration,
+		pushFailures,
+		exportedCollector(unifiedCollector, cfg.TenderdutyCompat),
+	)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(descriptors); err != nil {
+		slog.New(slog.NewJSONHandler(os.Stderr, nil)).Error("Failed to write metrics list", "error", err)
+		return 1
+	}
+	return 0
+}
+
+// runPrintConfig resolves auto-detected values, prints the config as YAML with secrets redacted and returns the exit code
+func runPrintConfig(cfg *config.Config) int {
+	logger := slog.New(slog.NewJSONHandler(os.Stderr, nil))
+
+	for i := range cfg.Chains {
+		chain := &cfg.Chains[i]
+		if chain.AutoDetect && chain.SDKVersion == "" {
+			nodeInfo, err := rpc.NewClient(chain.RPC, chain.API, chain.WebSocket).GetNodeInfo()
+			if err != nil {
+				logger.Warn("Failed to detect Cosmos SDK version", "chain_id", chain.ChainID, "error", err)
+				continue
+			}
+			chain.SDKVersion = nodeInfo.ApplicationVersion.CosmosSDKVersion
+		}
+	}
+
+	out, err := yaml.Marshal(cfg.Redacted())
+	if err != nil {
+		logger.Error("Failed to marshal config", "error", err)
+		return 1
+	}
+	os.Stdout.Write(out)
+	return 0
+}
+
+// parseHeightRange parses a "from:to" block height range
+func parseHeightRange(value string) (int64, int64, error) {
+	parts := strings.SplitN(value, ":", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("expected from:to, got %q", value)
+	}
+	from, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid from height %q: %w", parts[0], err)
+	}
+	to, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid to height %q: %w", parts[1], err)
+	}
+	if from < 1 || from > to {
+		return 0, 0, fmt.Errorf("invalid range %d:%d: from must be at least 1 and not above to", from, to)
+	}
+	return from, to, nil
+}
+
+// runOnce gathers the registry a single time, writes it to stdout in the text exposition format
+// and returns a non-zero exit code if any chain failed to collect
+func runOnce(registry *prometheus.Registry, chainCollectors []*collector.UnifiedCollector, logger *slog.Logger) int {
+	exitCode := 0
+
+	families, err := registry.Gather()
+	if err != nil {
+		logger.Error("Failed to gather metrics", "error", err)
+		exitCode = 1
+	}
+
+	for _, family := range families {
+		if _, err := expfmt.MetricFamilyToText(os.Stdout, family); err != nil {
+			logger.Error("Failed to write metrics", "error", err)
+			return 1
+		}
+	}
+
+	for _, c := range chainCollectors {
+		if err := c.LastCollectError(); err != nil {
+			logger.Error("Chain scrape failed", "chain_id", c.ChainID(), "error", err)
+			exitCode = 1
+		}
+	}
+
+	return exitCode
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+
+	"zerog-exporter/config"
+)
+
+// pushFailures counts pushes that still failed after every retry
+var pushFailures = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "zerog_push_failures_total",
+	Help: "Total number of Pushgateway pushes that failed after all retries",
+})
+
+// runPushLoop pushes the registry to the Pushgateway every interval until ctx is cancelled.
+// Each push replaces the previous metrics of the job / grouping labels group.
+func runPushLoop(ctx context.Context, gatherer prometheus.Gatherer, cfg config.Pushgateway, logger *slog.Logger) {
+	pusher := push.New(cfg.URL, cfg.Job).Gatherer(gatherer)
+	for name, value := range cfg.GroupingLabels {
+		pusher = pusher.Grouping(name, value)
+	}
+
+	ticker := time.NewTicker(time.Duration(cfg.Interval) * time.Second)
+	defer ticker.Stop()
+
+	retries := config.DefaultPushRetries
+	if cfg.Retries != nil {
+		retries = *cfg.Retries
+	}
+
+	for {
+		if err := pushWithRetry(ctx, pusher, retries); err != nil && ctx.Err() == nil {
+			pushFailures.Inc()
+			logger.Error("Failed to push metrics to Pushgateway", "url", cfg.URL, "job", cfg.Job, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pushWithRetry pushes once and retries up to retries times with a linear backoff
+func pushWithRetry(ctx context.Context, pusher *push.Pusher, retries int) error {
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * time.Second):
+			}
+		}
+		if err = pusher.PushContext(ctx); err == nil {
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+)
+
+// apiVersionPreferences lists the REST API versions tried for each module, most preferred first
+var apiVersionPreferences = map[string][]string{
+	"gov": {"v1", "v1beta1"},
+}
+
+// apiVersionCache holds the REST API version that answered for each module, shared by the copies of a Client
+type apiVersionCache struct {
+	mu       sync.RWMutex
+	versions map[string]string
+}
+
+// versionsFor returns the versions to try for module, starting with the one that worked last
+func (c *Client) versionsFor(module string) []string {
+	c.apiVersions.mu.RLock()
+	known, ok := c.apiVersions.versions[module]
+	c.apiVersions.mu.RUnlock()
+
+	preferred := apiVersionPreferences[module]
+	if !ok {
+		return preferred
+	}
+
+	versions := []string{known}
+	for _, version := range preferred {
+		if version != known {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// getVersioned queries module at path(version) for each candidate version, falling back to the next one
+// when the endpoint is not served (404/501), and remembers the version that answered
+func (c *Client) getVersioned(module string, path func(version string) string, ttl time.Duration, v interface{}) error {
+	var lastErr error
+	tried := make(map[string]bool)
+	for _, version := range c.versionsFor(module) {
+		url := c.apiURL + path(version)
+		// path override가 있으면 모든 version이 같은 url
+		if tried[url] {
+			continue
+		}
+		tried[url] = true
+
+		var err error
+		if ttl > 0 {
+			err = c.getCached(url, ttl, v)
+		} else {
+			err = c.get(url, v)
+		}
+		if err == nil {
+			c.apiVersions.mu.Lock()
+			c.apiVersions.versions[module] = version
+			c.apiVersions.mu.Unlock()
+			return nil
+		}
+		if !IsNotSupported(err) {
+			return err
+		}
+		lastErr = err
+	}
+	return lastErr
+}
+
+// APIVersions returns the REST API version that last answered for each module queried with version fallback
+func (c *Client) APIVersions() map[string]string {
+	c.apiVersions.mu.RLock()
+	defer c.apiVersions.mu.RUnlock()
+
+	versions := make(map[string]string, len(c.apiVersions.versions))
+	for module, version := range c.apiVersions.versions {
+		versions[module] = version
+	}
+	return versions
+}
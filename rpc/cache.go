@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+)
+
+// Default cache TTLs for slowly changing data (0 = no cache)
+const (
+	DefaultParamsCacheTTL     = 5 * time.Minute
+	DefaultValidatorsCacheTTL = 1 * time.Minute
+	DefaultSupplyCacheTTL     = 30 * time.Second
+)
+
+// CacheTTLs are the refresh intervals of the slowly changing data classes; block height, blocks and signing
+// data are never cached
+type CacheTTLs struct {
+	Params     time.Duration // chain params, denom metadata, IBC escrow addresses
+	Validators time.Duration // validator set (monikers, tokens, commission)
+	Supply     time.Duration // bank supply, staking pool, community pool
+}
+
+type cacheEntry struct {
+	body         []byte
+	expires      time.Time
+	etag         string
+	lastModified string
+}
+
+// valueEntry is a decoded response cached instead of its raw body (responses decoded while streaming)
+type valueEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// responseCache stores raw response bodies, or decoded values for streamed responses, keyed by request URL
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	values  map[string]valueEntry
+	hits    uint64
+	misses  uint64
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{
+		entries: make(map[string]cacheEntry),
+		values:  make(map[string]valueEntry),
+	}
+}
+
+// lookup returns the cached body for url if it has not expired
+func (rc *responseCache) lookup(url string) ([]byte, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[url]
+	if !ok || time.Now().After(entry.expires) {
+		rc.misses++
+		return nil, false
+	}
+	rc.hits++
+	return entry.body, true
+}
+
+// stale returns the (possibly expired) entry for url if it can be revalidated with a conditional request
+func (rc *responseCache) stale(url string) (cacheEntry, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[url]
+	if !ok || (entry.etag == "" && entry.lastModified == "") {
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+// store caches body for url for the given ttl, along with its ETag / Last-Modified validators
+func (rc *responseCache) store(url string, body []byte, ttl time.Duration, etag, lastModified string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.entries[url] = cacheEntry{
+		body:         body,
+		expires:      time.Now().Add(ttl),
+		etag:         etag,
+		lastModified: lastModified,
+	}
+}
+
+// lookupValue returns the decoded value cached for key if it has not expired
+func (rc *responseCache) lookupValue(key string) (interface{}, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.values[key]
+	if !ok || time.Now().After(entry.expires) {
+		rc.misses++
+		return nil, false
+	}
+	rc.hits++
+	return entry.value, true
+}
+
+// storeValue caches a decoded value for key for the given ttl
+func (rc *responseCache) storeValue(key string, value interface{}, ttl time.Duration) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.values[key] = valueEntry{value: value, expires: time.Now().Add(ttl)}
+}
+
+// stats returns the cache hit and miss counts
+func (rc *responseCache) stats() (hits, misses uint64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.hits, rc.misses
+}
@@ -0,0 +1,116 @@
+package rpc
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// circuitBreaker fast-fails requests to an endpoint after consecutive failures. Once the cooldown has passed
+// a single probe request is let through; its success closes the breaker, its failure re-opens it.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	open      bool
+	openedAt  time.Time
+	probing   bool
+}
+
+// allow reports whether a request may be sent
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.threshold <= 0 || !b.open {
+		return true
+	}
+	if b.probing || time.Since(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// record updates the breaker with the outcome of a request
+func (b *circuitBreaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.threshold <= 0 {
+		return
+	}
+	if !isEndpointFailure(err) {
+		b.failures = 0
+		b.open = false
+		b.probing = false
+		return
+	}
+
+	b.failures++
+	if b.probing || b.failures >= b.threshold {
+		b.open = true
+		b.openedAt = time.Now()
+	}
+	b.probing = false
+}
+
+// release ends a request without an outcome (e.g. canceled by the caller), letting a new probe through later
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *circuitBreaker) isOpen() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.open
+}
+
+// isEndpointFailure reports whether err means the endpoint itself is unhealthy (transport errors and 5xx other
+// than 501); 4xx responses, decode errors and oversized responses come from a working node
+func isEndpointFailure(err error) bool {
+	if err == nil || errors.Is(err, ErrResponseTooLarge) {
+		return false
+	}
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code >= 500 && statusErr.Code != http.StatusNotImplemented
+	}
+	return true
+}
+
+// SetCircuitBreaker configures the per-endpoint (RPC / REST API) circuit breakers: after threshold consecutive
+// failures requests fast-fail for cooldown before a probe is sent (threshold <= 0 disables them)
+func (c *Client) SetCircuitBreaker(threshold int, cooldown time.Duration) {
+	for _, breaker := range c.breakers {
+		breaker.mu.Lock()
+		breaker.threshold = threshold
+		breaker.cooldown = cooldown
+		breaker.mu.Unlock()
+	}
+}
+
+// breakerFor returns the name and circuit breaker of the endpoint serving url
+func (c *Client) breakerFor(url string) (string, *circuitBreaker) {
+	if c.archiveRPCURL != "" && strings.HasPrefix(url, c.archiveRPCURL) {
+		return "archive", c.breakers["archive"]
+	}
+	if c.rpcURL != "" && strings.HasPrefix(url, c.rpcURL) {
+		return "rpc", c.breakers["rpc"]
+	}
+	return "api", c.breakers["api"]
+}
+
+// CircuitStates reports whether the circuit breaker of each endpoint is open
+func (c *Client) CircuitStates() map[string]bool {
+	states := make(map[string]bool, len(c.breakers))
+	for endpoint, breaker := range c.breakers {
+		states[endpoint] = breaker.isOpen()
+	}
+	return states
+}
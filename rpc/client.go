@@ -1,39 +1,440 @@
 package rpc
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Client struct {
-	rpcURL  string
-	apiURL  string
-	wsURL   string
+	rpcURL     string
+	apiURL     string
+	wsURL      string
+	httpClient *http.Client
+	cache      *responseCache
+	pageLimit  int
+
+	inflationPath        string
+	annualProvisionsPath string
+
+	conditionalRequests bool
+
+	// 모듈별로 응답한 REST API 버전 (gov v1 / v1beta1 등)
+	apiVersions *apiVersionCache
+
+	breakers map[string]*circuitBreaker
+
+	// rest_paths: 모듈별 REST base path override (없으면 DefaultModulePaths)
+	modulePaths map[string]string
+
+	maxBodySize   int64 // 응답 body 최대 크기 (0 = 제한 없음)
+	maxValidators int   // GetValidators가 처리하는 최대 validator 수 (0 = 제한 없음)
+
+	// 특정 height 조회 (block, block_results, validators)용 archive RPC (비어 있으면 rpcURL 사용)
+	archiveRPCURL string
+
+	cacheTTLs CacheTTLs
+
+	// 요청에 사용하는 context (nil = context.Background, WithContext로 설정)
+	ctx context.Context
+}
+
+// DefaultPageLimit is the page size used for paginated REST queries when none is configured
+const DefaultPageLimit = 100
+
+type Pagination struct {
+	NextKey string `json:"next_key"`
+	Total   string `json:"total"`
 }
 
 func NewClient(rpcURL, apiURL, wsURL string) *Client {
+	return NewClientWithHTTPClient(rpcURL, apiURL, wsURL, &http.Client{
+		Timeout: 10 * time.Second,
+	})
+}
+
+// NewClientWithHTTPClient creates a Client that sends requests through the given http.Client
+// (e.g. the client of an httptest.Server serving canned responses)
+func NewClientWithHTTPClient(rpcURL, apiURL, wsURL string, httpClient *http.Client) *Client {
 	return &Client{
-		rpcURL: rpcURL,
-		apiURL: apiURL,
-		wsURL:  wsURL,
+		rpcURL:     rpcURL,
+		apiURL:     apiURL,
+		wsURL:      wsURL,
+		httpClient: httpClient,
+		cache:      newResponseCache(),
+		pageLimit:  DefaultPageLimit,
+		apiVersions: &apiVersionCache{versions: make(map[string]string)},
+		cacheTTLs: CacheTTLs{
+			Params:     DefaultParamsCacheTTL,
+			Validators: DefaultValidatorsCacheTTL,
+			Supply:     DefaultSupplyCacheTTL,
+		},
+		breakers: map[string]*circuitBreaker{
+			"rpc": {},
+			"api": {},
+		},
+
+	}
+}
+
+// WithContext returns a copy of the client whose requests are canceled with ctx. The copy shares the response
+// cache, circuit breakers and detected API versions with c.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+func (c *Client) context() context.Context {
+	if c.ctx != nil {
+		return c.ctx
+	}
+	return context.Background()
+}
+
+// recordRequest updates the breaker with the outcome of a request; requests aborted because the client's
+// context was canceled say nothing about the endpoint and are not counted
+func (c *Client) recordRequest(breaker *circuitBreaker, err error) {
+	if err != nil && c.ctx != nil && c.ctx.Err() != nil {
+		breaker.release()
+		return
+	}
+	breaker.record(err)
+}
+
+// SetPageLimit sets the page size used for paginated REST queries
+func (c *Client) SetPageLimit(limit int) {
+	if limit > 0 {
+		c.pageLimit = limit
+	}
+}
+
+// SetMintPaths overrides the REST paths of the inflation and annual provisions queries for chains with a
+// custom mint module; empty paths keep the endpoints under the mint module path (see SetModulePaths)
+func (c *Client) SetMintPaths(inflationPath, annualProvisionsPath string) {
+	if inflationPath != "" {
+		c.inflationPath = inflationPath
+	}
+	if annualProvisionsPath != "" {
+		c.annualProvisionsPath = annualProvisionsPath
+	}
+}
+
+// SetMaxBodySize limits the size of response bodies read from the node (0 = unlimited); larger responses fail
+// with ErrResponseTooLarge
+func (c *Client) SetMaxBodySize(bytes int64) {
+	c.maxBodySize = bytes
+}
+
+// SetMaxValidators limits the number of staking validators GetValidators processes (0 = unlimited)
+func (c *Client) SetMaxValidators(max int) {
+	c.maxValidators = max
+}
+
+// SetArchiveRPC routes queries at a specific height (blocks, block results, validator sets) to an archive node,
+// so the primary endpoint can be a pruned node. Queries fall back to the primary when the archive has not
+// reached the height yet. It gets its own "archive" circuit breaker configured like the primary RPC's.
+func (c *Client) SetArchiveRPC(archiveRPCURL string) {
+	if archiveRPCURL == "" || archiveRPCURL == c.rpcURL {
+		return
 	}
+	c.archiveRPCURL = archiveRPCURL
+
+	primary := c.breakers["rpc"]
+	primary.mu.Lock()
+	c.breakers["archive"] = &circuitBreaker{threshold: primary.threshold, cooldown: primary.cooldown}
+	primary.mu.Unlock()
+}
+
+// getAtHeight fetches pathAndQuery for a specific height from the archive RPC when configured (height 0 = latest,
+// always served by the primary). A height the archive doesn't have yet is retried on the primary.
+func (c *Client) getAtHeight(height int, pathAndQuery string, v interface{}) error {
+	if height <= 0 || c.archiveRPCURL == "" {
+		return c.get(c.rpcURL+pathAndQuery, v)
+	}
+
+	err := c.get(c.archiveRPCURL+pathAndQuery, v)
+	var heightErr *HeightNotAvailableError
+	if errors.As(asHeightNotAvailable(err, int64(height)), &heightErr) && !heightErr.Pruned {
+		return c.get(c.rpcURL+pathAndQuery, v)
+	}
+	return err
+}
+
+// SetCacheTTLs overrides the refresh interval of each slowly changing data class; zero values keep the default
+func (c *Client) SetCacheTTLs(ttls CacheTTLs) {
+	if ttls.Params > 0 {
+		c.cacheTTLs.Params = ttls.Params
+	}
+	if ttls.Validators > 0 {
+		c.cacheTTLs.Validators = ttls.Validators
+	}
+	if ttls.Supply > 0 {
+		c.cacheTTLs.Supply = ttls.Supply
+	}
+}
+
+// SetConditionalRequests enables ETag / Last-Modified revalidation of expired cache entries
+// (not every REST gateway honors conditional requests)
+func (c *Client) SetConditionalRequests(enabled bool) {
+	c.conditionalRequests = enabled
 }
 
+// maxErrorBodySnippet is the number of response body bytes included in decode errors
+const maxErrorBodySnippet = 256
+
 func (c *Client) get(url string, v interface{}) error {
-	resp, err := http.Get(url)
+	body, err := c.fetch(url)
 	if err != nil {
 		return err
 	}
+	return withEndpoint(decodeBody(body, v), url)
+}
+
+// decodeBody unmarshals body into v, including a truncated snippet of the raw body on failure
+func decodeBody(body []byte, v interface{}) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return decodeErrorFor(body, err)
+	}
+	return nil
+}
+
+// decodeErrorFor wraps a decode failure of body in a DecodeError with a truncated body snippet
+func decodeErrorFor(body []byte, err error) error {
+	snippet := string(body)
+	if len(snippet) > maxErrorBodySnippet {
+		snippet = snippet[:maxErrorBodySnippet] + "..."
+	}
+	return &DecodeError{Err: err, Body: snippet}
+}
+
+// getCached is like get but serves the response from the cache while it is younger than ttl
+func (c *Client) getCached(url string, ttl time.Duration, v interface{}) error {
+	body, err := c.fetchCached(url, ttl, func(body []byte) error { return decodeBody(body, v) })
+	if err != nil {
+		return withEndpoint(err, url)
+	}
+	return withEndpoint(decodeBody(body, v), url)
+}
+
+// fetchCached returns the cached body for url, or fetches it and caches it once validate accepts it (ttl 0 = no cache)
+func (c *Client) fetchCached(url string, ttl time.Duration, validate func(body []byte) error) ([]byte, error) {
+	if ttl <= 0 {
+		return c.fetch(url)
+	}
+
+	if body, ok := c.cache.lookup(url); ok {
+		return body, nil
+	}
+
+	// 만료된 항목의 ETag/Last-Modified로 조건부 요청
+	var stale cacheEntry
+	hasStale := false
+	if c.conditionalRequests {
+		stale, hasStale = c.cache.stale(url)
+	}
+
+	res, err := c.fetchConditional(url, stale.etag, stale.lastModified)
+	if err != nil {
+		return nil, err
+	}
+	if res.notModified {
+		if !hasStale {
+			return nil, &HTTPStatusError{Code: http.StatusNotModified, Endpoint: url}
+		}
+		c.cache.store(url, stale.body, ttl, stale.etag, stale.lastModified)
+		return stale.body, nil
+	}
+	if err := validate(res.body); err != nil {
+		return nil, err
+	}
+
+	c.cache.store(url, res.body, ttl, res.etag, res.lastModified)
+	return res.body, nil
+}
+
+// getAllPages follows pagination.next_key over a paginated REST endpoint, passing each page body to
+// handlePage which decodes it, accumulates its results and returns the page's next_key
+func (c *Client) getAllPages(baseURL string, ttl time.Duration, handlePage func(body []byte) (string, error)) error {
+	separator := "?"
+	if strings.Contains(baseURL, "?") {
+		separator = "&"
+	}
+
+	nextKey := ""
+	for {
+		pageURL := fmt.Sprintf("%s%spagination.limit=%d", baseURL, separator, c.pageLimit)
+		if nextKey != "" {
+			pageURL += "&pagination.key=" + url.QueryEscape(nextKey)
+		}
+
+		body, err := c.fetchCached(pageURL, ttl, func(body []byte) error { return decodeBody(body, &struct{}{}) })
+		if err != nil {
+			return withEndpoint(err, pageURL)
+		}
+
+		nextKey, err = handlePage(body)
+		if err != nil {
+			return withEndpoint(err, pageURL)
+		}
+		if nextKey == "" {
+			return nil
+		}
+	}
+}
+
+// fetchResponse is a fetched body with the validators needed for conditional requests
+type fetchResponse struct {
+	body         []byte
+	etag         string
+	lastModified string
+	notModified  bool
+}
+
+func (c *Client) fetch(url string) ([]byte, error) {
+	res, err := c.fetchConditional(url, "", "")
+	if err != nil {
+		return nil, err
+	}
+	return res.body, nil
+}
+
+// fetchConditional fetches url, sending If-None-Match / If-Modified-Since when etag / lastModified are set
+func (c *Client) fetchConditional(url, etag, lastModified string) (*fetchResponse, error) {
+	endpoint, breaker := c.breakerFor(url)
+	if !breaker.allow() {
+		return nil, &CircuitOpenError{Endpoint: endpoint}
+	}
+
+	start := time.Now()
+	res, err := c.doFetch(url, etag, lastModified)
+	c.recordRequest(breaker, err)
+
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	RequestDuration.WithLabelValues(normalizeEndpoint(url), result).Observe(time.Since(start).Seconds())
+
+	return res, err
+}
+
+// do sends a GET request for url, sending If-None-Match / If-Modified-Since when etag / lastModified are set
+func (c *Client) do(url, etag, lastModified string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(c.context(), http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+	return c.httpClient.Do(req)
+}
+
+func (c *Client) doFetch(url, etag, lastModified string) (*fetchResponse, error) {
+	resp, err := c.do(url, etag, lastModified)
+	if err != nil {
+		return nil, err
+	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified && (etag != "" || lastModified != "") {
+		return &fetchResponse{notModified: true}, nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		return nil, &HTTPStatusError{Code: resp.StatusCode, Body: string(body), Endpoint: url}
 	}
 
-	return json.NewDecoder(resp.Body).Decode(v)
+	var reader io.Reader = resp.Body
+	if c.maxBodySize > 0 {
+		reader = io.LimitReader(resp.Body, c.maxBodySize+1)
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if c.maxBodySize > 0 && int64(len(body)) > c.maxBodySize {
+		return nil, fmt.Errorf("%w: %s exceeds %d bytes", ErrResponseTooLarge, url, c.maxBodySize)
+	}
+	return &fetchResponse{
+		body:         body,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
+
+// fetchStream fetches url and passes the response body to decode while it is read, without buffering or caching
+// the body. Bodies over maxBodySize fail with ErrResponseTooLarge; only the request itself counts for the breaker.
+func (c *Client) fetchStream(url string, decode func(io.Reader) error) error {
+	endpoint, breaker := c.breakerFor(url)
+	if !breaker.allow() {
+		return &CircuitOpenError{Endpoint: endpoint}
+	}
+
+	start := time.Now()
+	resp, err := c.do(url, "", "")
+	if err == nil && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		err = &HTTPStatusError{Code: resp.StatusCode, Body: string(body), Endpoint: url}
+	}
+	c.recordRequest(breaker, err)
+	if err == nil {
+		defer resp.Body.Close()
+		var reader io.Reader = resp.Body
+		if c.maxBodySize > 0 {
+			reader = &limitedBodyReader{reader: resp.Body, remaining: c.maxBodySize, url: url, max: c.maxBodySize}
+		}
+		err = decode(reader)
+	}
+
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	RequestDuration.WithLabelValues(normalizeEndpoint(url), result).Observe(time.Since(start).Seconds())
+	return err
+}
+
+// limitedBodyReader fails with ErrResponseTooLarge once more than max bytes are read
+type limitedBodyReader struct {
+	reader    io.Reader
+	remaining int64
+	url       string
+	max       int64
+}
+
+func (r *limitedBodyReader) Read(p []byte) (int, error) {
+	if r.remaining < 0 {
+		return 0, fmt.Errorf("%w: %s exceeds %d bytes", ErrResponseTooLarge, r.url, r.max)
+	}
+	// 한도 + 1 byte까지 읽어 초과 여부 확인
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+	n, err := r.reader.Read(p)
+	r.remaining -= int64(n)
+	if r.remaining < 0 {
+		return n, fmt.Errorf("%w: %s exceeds %d bytes", ErrResponseTooLarge, r.url, r.max)
+	}
+	return n, err
+}
+
+// CacheStats returns the number of response cache hits and misses
+func (c *Client) CacheStats() (hits, misses uint64) {
+	return c.cache.stats()
 }
 
 type StakingPoolResponse struct {
@@ -45,7 +446,7 @@ type StakingPoolResponse struct {
 
 func (c *Client) GetStakingPool() (*StakingPoolResponse, error) {
 	var res StakingPoolResponse
-	err := c.get(c.apiURL+"/cosmos/staking/v1beta1/pool", &res)
+	err := c.getCached(c.apiURL+c.modulePath("staking")+"/pool", c.cacheTTLs.Supply, &res)
 	return &res, err
 }
 
@@ -58,7 +459,7 @@ type CommunityPoolResponse struct {
 
 func (c *Client) GetCommunityPool() (*CommunityPoolResponse, error) {
 	var res CommunityPoolResponse
-	err := c.get(c.apiURL+"/cosmos/distribution/v1beta1/community_pool", &res)
+	err := c.getCached(c.apiURL+c.modulePath("distribution")+"/community_pool", c.cacheTTLs.Supply, &res)
 	return &res, err
 }
 
@@ -71,7 +472,36 @@ type BankSupplyResponse struct {
 
 func (c *Client) GetBankSupply() (*BankSupplyResponse, error) {
 	var res BankSupplyResponse
-	err := c.get(c.apiURL+"/cosmos/bank/v1beta1/supply", &res)
+	err := c.getCached(c.apiURL+c.modulePath("bank")+"/supply", c.cacheTTLs.Supply, &res)
+	return &res, err
+}
+
+type DenomsMetadataResponse struct {
+	Metadatas []struct {
+		Description string `json:"description"`
+		DenomUnits  []struct {
+			Denom    string   `json:"denom"`
+			Exponent int      `json:"exponent"`
+			Aliases  []string `json:"aliases"`
+		} `json:"denom_units"`
+		Base    string `json:"base"`
+		Display string `json:"display"`
+		Name    string `json:"name"`
+		Symbol  string `json:"symbol"`
+	} `json:"metadatas"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (c *Client) GetDenomsMetadata() (*DenomsMetadataResponse, error) {
+	var res DenomsMetadataResponse
+	err := c.getAllPages(c.apiURL+c.modulePath("bank")+"/denoms_metadata", c.cacheTTLs.Params, func(body []byte) (string, error) {
+		var page DenomsMetadataResponse
+		if err := decodeBody(body, &page); err != nil {
+			return "", err
+		}
+		res.Metadatas = append(res.Metadatas, page.Metadatas...)
+		return page.Pagination.NextKey, nil
+	})
 	return &res, err
 }
 
@@ -81,7 +511,11 @@ type MintingInflationResponse struct {
 
 func (c *Client) GetMintingInflation() (*MintingInflationResponse, error) {
 	var res MintingInflationResponse
-	err := c.get(c.apiURL+"/cosmos/mint/v1beta1/inflation", &res)
+	path := c.inflationPath
+	if path == "" {
+		path = c.modulePath("mint") + "/inflation"
+	}
+	err := c.get(c.apiURL+path, &res)
 	return &res, err
 }
 
@@ -91,48 +525,215 @@ type MintingAnnualProvisionsResponse struct {
 
 func (c *Client) GetMintingAnnualProvisions() (*MintingAnnualProvisionsResponse, error) {
 	var res MintingAnnualProvisionsResponse
-	err := c.get(c.apiURL+"/cosmos/mint/v1beta1/annual_provisions", &res)
+	path := c.annualProvisionsPath
+	if path == "" {
+		path = c.modulePath("mint") + "/annual_provisions"
+	}
+	err := c.get(c.apiURL+path, &res)
 	return &res, err
 }
 
 type ValidatorsResponse struct {
-	Validators []struct {
-		OperatorAddress   string `json:"operator_address"`
-		ConsensusPubkey   struct {
-			Key string `json:"key"`
-		} `json:"consensus_pubkey"`
-		Jailed            bool   `json:"jailed"`
-		Status            string `json:"status"`
-		Tokens            string `json:"tokens"`
-		DelegatorShares  string `json:"delegator_shares"`
-		Description       struct {
-			Moniker string `json:"moniker"`
-		} `json:"description"`
-		Commission struct {
-			CommissionRates struct {
-				Rate string `json:"rate"`
-			} `json:"commission_rates"`
-		} `json:"commission"`
-		ConsensusAddress string `json:"consensus_address"`
-	} `json:"validators"`
+	Validators []Validator `json:"validators"`
+	Pagination Pagination  `json:"pagination"`
+	// Truncated is set when max_validators cut the list short; it then doesn't cover the whole validator set
+	Truncated bool `json:"-"`
 }
 
+// Validator is a staking module validator
+type Validator struct {
+	OperatorAddress   string `json:"operator_address"`
+	ConsensusPubkey   ConsensusPubkey `json:"consensus_pubkey"`
+	Jailed            bool   `json:"jailed"`
+	Status            string `json:"status"`
+	Tokens            string `json:"tokens"`
+	DelegatorShares  string `json:"delegator_shares"`
+	Description       struct {
+		Moniker string `json:"moniker"`
+	} `json:"description"`
+	Commission struct {
+		CommissionRates struct {
+			Rate          string `json:"rate"`
+			MaxRate       string `json:"max_rate"`
+			MaxChangeRate string `json:"max_change_rate"`
+		} `json:"commission_rates"`
+		UpdateTime string `json:"update_time"`
+	} `json:"commission"`
+	ConsensusAddress string `json:"consensus_address"`
+}
+
+// ConsensusPubkey is a validator consensus public key. It accepts the proto JSON form
+// ({"@type": ..., "key": ...}), the legacy amino form ({"type": ..., "value": ...}) and a bare string.
+type ConsensusPubkey struct {
+	Type string
+	Key  string
+}
+
+func (p *ConsensusPubkey) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		p.Key = str
+		return nil
+	}
+
+	var raw struct {
+		AtType string `json:"@type"`
+		Type   string `json:"type"`
+		Key    string `json:"key"`
+		Value  string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	p.Type = raw.AtType
+	if p.Type == "" {
+		p.Type = raw.Type
+	}
+	p.Key = raw.Key
+	if p.Key == "" {
+		p.Key = raw.Value
+	}
+	return nil
+}
+
+// GetValidators returns the staking validators, following pagination. Pages are decoded from the response body
+// one validator at a time as it is read, so a page is never held in memory as a whole; the decoded list is cached
+// for the validators TTL. At most maxValidators (when set) are returned, with Truncated set when more exist.
 func (c *Client) GetValidators() (*ValidatorsResponse, error) {
+	baseURL := c.apiURL + c.modulePath("staking") + "/validators"
+	if cached, ok := c.cache.lookupValue(baseURL); ok {
+		return cached.(*ValidatorsResponse), nil
+	}
+
 	var res ValidatorsResponse
-	err := c.get(c.apiURL+"/cosmos/staking/v1beta1/validators?pagination.limit=1000", &res)
-	return &res, err
+	nextKey := ""
+	for {
+		pageURL := fmt.Sprintf("%s?pagination.limit=%d", baseURL, c.pageLimit)
+		if nextKey != "" {
+			pageURL += "&pagination.key=" + url.QueryEscape(nextKey)
+		}
+
+		full := false
+		err := c.fetchStream(pageURL, func(body io.Reader) error {
+			var err error
+			nextKey, err = decodeValidatorsPage(body, func(validator Validator) bool {
+				if c.maxValidators > 0 && len(res.Validators) >= c.maxValidators {
+					full = true
+					return false
+				}
+				res.Validators = append(res.Validators, validator)
+				return true
+			})
+			return err
+		})
+		if err != nil {
+			return &res, withEndpoint(err, pageURL)
+		}
+		// 한도에 도달한 뒤 남은 validator가 있으면 truncated
+		if full || (c.maxValidators > 0 && len(res.Validators) >= c.maxValidators && nextKey != "") {
+			res.Truncated = true
+			break
+		}
+		if nextKey == "" {
+			break
+		}
+	}
+
+	c.cache.storeValue(baseURL, &res, c.cacheTTLs.Validators)
+	return &res, nil
+}
+
+// decodeValidatorsPage streams the validators of a page from body to handle without decoding the whole array at
+// once, stopping early when handle returns false, and returns the page's next_key
+func decodeValidatorsPage(body io.Reader, handle func(Validator) bool) (string, error) {
+	decoder := json.NewDecoder(body)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return "", streamDecodeError(err)
+	}
+
+	var pagination Pagination
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", streamDecodeError(err)
+		}
+		switch token {
+		case "validators":
+			if err := expectDelim(decoder, '['); err != nil {
+				return "", streamDecodeError(err)
+			}
+			for decoder.More() {
+				var validator Validator
+				if err := decoder.Decode(&validator); err != nil {
+					return "", streamDecodeError(err)
+				}
+				if !handle(validator) {
+					return "", nil
+				}
+			}
+			if err := expectDelim(decoder, ']'); err != nil {
+				return "", streamDecodeError(err)
+			}
+		case "pagination":
+			if err := decoder.Decode(&pagination); err != nil {
+				return "", streamDecodeError(err)
+			}
+		default:
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return "", streamDecodeError(err)
+			}
+		}
+	}
+	return pagination.NextKey, nil
+}
+
+// streamDecodeError wraps a failure while decoding a streamed body in a DecodeError (the body is not kept, so
+// there is no snippet); an oversized body stays ErrResponseTooLarge
+func streamDecodeError(err error) error {
+	if errors.Is(err, ErrResponseTooLarge) {
+		return err
+	}
+	return &DecodeError{Err: err, Body: "<streamed>"}
+}
+
+// expectDelim reads the next token and checks that it is the given delimiter
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v, got %v", delim, token)
+	}
+	return nil
+}
+
+type SigningInfo struct {
+	Address             string `json:"address"`
+	StartHeight         string `json:"start_height"`
+	IndexOffset         string `json:"index_offset"`
+	JailedUntil         string `json:"jailed_until"`
+	Tombstoned          bool   `json:"tombstoned"`
+	MissedBlocksCounter string `json:"missed_blocks_counter"`
 }
 
 type SigningInfosResponse struct {
-	Info []struct {
-		Address             string `json:"address"`
-		MissedBlocksCounter string `json:"missed_blocks_counter"`
-	} `json:"info"`
+	Info       []SigningInfo `json:"info"`
+	Pagination Pagination    `json:"pagination"`
 }
 
 func (c *Client) GetSigningInfos() (*SigningInfosResponse, error) {
 	var res SigningInfosResponse
-	err := c.get(c.apiURL+"/cosmos/slashing/v1beta1/signing_infos?pagination.limit=1000", &res)
+	err := c.getAllPages(c.apiURL+c.modulePath("slashing")+"/signing_infos", 0, func(body []byte) (string, error) {
+		var page SigningInfosResponse
+		if err := decodeBody(body, &page); err != nil {
+			return "", err
+		}
+		res.Info = append(res.Info, page.Info...)
+		return page.Pagination.NextKey, nil
+	})
 	return &res, err
 }
 
@@ -147,7 +748,7 @@ type ValidatorCommissionResponse struct {
 
 func (c *Client) GetValidatorCommission(validatorAddress string) (*ValidatorCommissionResponse, error) {
 	var res ValidatorCommissionResponse
-	err := c.get(c.apiURL+"/cosmos/distribution/v1beta1/validators/"+validatorAddress+"/commission", &res)
+	err := c.get(c.apiURL+c.modulePath("distribution")+"/validators/"+validatorAddress+"/commission", &res)
 	return &res, err
 }
 
@@ -162,20 +763,17 @@ type ValidatorRewardsResponse struct {
 
 func (c *Client) GetValidatorRewards(validatorAddress string) (*ValidatorRewardsResponse, error) {
 	var res ValidatorRewardsResponse
-	err := c.get(c.apiURL+"/cosmos/distribution/v1beta1/validators/"+validatorAddress+"/rewards", &res)
+	err := c.get(c.apiURL+c.modulePath("distribution")+"/validators/"+validatorAddress+"/rewards", &res)
 	return &res, err
 }
 
 type WalletBalanceResponse struct {
-	Balances []struct {
-		Amount string `json:"amount"`
-		Denom  string `json:"denom"`
-	} `json:"balances"`
+	Balances []Coin `json:"balances"`
 }
 
 func (c *Client) GetWalletBalance(address string) (*WalletBalanceResponse, error) {
 	var res WalletBalanceResponse
-	err := c.get(c.apiURL+"/cosmos/bank/v1beta1/balances/"+address, &res)
+	err := c.get(c.apiURL+c.modulePath("bank")+"/balances/"+address, &res)
 	return &res, err
 }
 
@@ -189,11 +787,19 @@ type WalletDelegationsResponse struct {
 			Denom  string `json:"denom"`
 		} `json:"balance"`
 	} `json:"delegation_responses"`
+	Pagination Pagination `json:"pagination"`
 }
 
 func (c *Client) GetWalletDelegations(address string) (*WalletDelegationsResponse, error) {
 	var res WalletDelegationsResponse
-	err := c.get(c.apiURL+"/cosmos/staking/v1beta1/delegations/"+address, &res)
+	err := c.getAllPages(c.apiURL+c.modulePath("staking")+"/delegations/"+address, 0, func(body []byte) (string, error) {
+		var page WalletDelegationsResponse
+		if err := decodeBody(body, &page); err != nil {
+			return "", err
+		}
+		res.DelegationResponses = append(res.DelegationResponses, page.DelegationResponses...)
+		return page.Pagination.NextKey, nil
+	})
 	return &res, err
 }
 
@@ -206,7 +812,17 @@ type WalletRewardsResponse struct {
 
 func (c *Client) GetWalletRewards(address string) (*WalletRewardsResponse, error) {
 	var res WalletRewardsResponse
-	err := c.get(c.apiURL+"/cosmos/distribution/v1beta1/delegators/"+address+"/rewards", &res)
+	err := c.get(c.apiURL+c.modulePath("distribution")+"/delegators/"+address+"/rewards", &res)
+	return &res, err
+}
+
+type DelegationRewardsResponse struct {
+	Rewards []Coin `json:"rewards"`
+}
+
+func (c *Client) GetDelegationRewards(delegatorAddress, validatorAddress string) (*DelegationRewardsResponse, error) {
+	var res DelegationRewardsResponse
+	err := c.get(c.apiURL+c.modulePath("distribution")+"/delegators/"+delegatorAddress+"/rewards/"+validatorAddress, &res)
 	return &res, err
 }
 
@@ -218,11 +834,34 @@ type WalletUnbondingResponse struct {
 			CompletionTime string `json:"completion_time"`
 		} `json:"entries"`
 	} `json:"unbonding_responses"`
+	Pagination Pagination `json:"pagination"`
 }
 
 func (c *Client) GetWalletUnbonding(address string) (*WalletUnbondingResponse, error) {
 	var res WalletUnbondingResponse
-	err := c.get(c.apiURL+"/cosmos/staking/v1beta1/delegators/"+address+"/unbonding_delegations?pagination.limit=1000", &res)
+	err := c.getAllPages(c.apiURL+c.modulePath("staking")+"/delegators/"+address+"/unbonding_delegations", 0, func(body []byte) (string, error) {
+		var page WalletUnbondingResponse
+		if err := decodeBody(body, &page); err != nil {
+			return "", err
+		}
+		res.UnbondingResponses = append(res.UnbondingResponses, page.UnbondingResponses...)
+		return page.Pagination.NextKey, nil
+	})
+	return &res, err
+}
+
+// GetValidatorUnbonding returns the unbonding delegations to a validator (operator address), following
+// next_key pagination. The response has the same shape as the delegator unbonding query.
+func (c *Client) GetValidatorUnbonding(operatorAddress string) (*WalletUnbondingResponse, error) {
+	var res WalletUnbondingResponse
+	err := c.getAllPages(c.apiURL+c.modulePath("staking")+"/validators/"+operatorAddress+"/unbonding_delegations", c.cacheTTLs.Validators, func(body []byte) (string, error) {
+		var page WalletUnbondingResponse
+		if err := decodeBody(body, &page); err != nil {
+			return "", err
+		}
+		res.UnbondingResponses = append(res.UnbondingResponses, page.UnbondingResponses...)
+		return page.Pagination.NextKey, nil
+	})
 	return &res, err
 }
 
@@ -250,44 +889,60 @@ func (c *Client) GetChainConfig() (*ChainConfigResponse, error) {
 type NodeInfoResponse struct {
 	DefaultNodeInfo struct {
 		Network string `json:"network"`
+		Version string `json:"version"`
+		Moniker string `json:"moniker"`
 	} `json:"default_node_info"`
+	ApplicationVersion struct {
+		Name             string `json:"name"`
+		AppName          string `json:"app_name"`
+		Version          string `json:"version"`
+		GitCommit        string `json:"git_commit"`
+		GoVersion        string `json:"go_version"`
+		CosmosSDKVersion string `json:"cosmos_sdk_version"`
+	} `json:"application_version"`
 }
 
 func (c *Client) GetNodeInfo() (*NodeInfoResponse, error) {
 	var res NodeInfoResponse
-	err := c.get(c.apiURL+"/cosmos/base/tendermint/v1beta1/node_info", &res)
+	err := c.get(c.apiURL+c.modulePath("tendermint")+"/node_info", &res)
 	return &res, err
 }
 
 type StakingParamsResponse struct {
 	Params struct {
-		MaxValidators int `json:"max_validators"`
+		MaxValidators int    `json:"max_validators"`
+		BondDenom     string `json:"bond_denom"`
 	} `json:"params"`
 }
 
 func (c *Client) GetStakingParams() (*StakingParamsResponse, error) {
 	var res StakingParamsResponse
-	err := c.get(c.apiURL+"/cosmos/staking/v1beta1/params", &res)
+	err := c.getCached(c.apiURL+c.modulePath("staking")+"/params", c.cacheTTLs.Params, &res)
 	return &res, err
 }
 
 type DistributionParamsResponse struct {
 	Params struct {
+		CommunityTax          string `json:"community_tax"`
 		BaseProposerReward    string `json:"base_proposer_reward"`
 		BonusProposerReward   string `json:"bonus_proposer_reward"`
+		WithdrawAddrEnabled   bool   `json:"withdraw_addr_enabled"`
 	} `json:"params"`
 }
 
 func (c *Client) GetDistributionParams() (*DistributionParamsResponse, error) {
 	var res DistributionParamsResponse
-	err := c.get(c.apiURL+"/cosmos/distribution/v1beta1/params", &res)
+	err := c.getCached(c.apiURL+c.modulePath("distribution")+"/params", c.cacheTTLs.Params, &res)
 	return &res, err
 }
 
 type GovernanceProposalsResponse struct {
 	Proposals []struct {
 		ProposalID string `json:"proposal_id"`
+		ID         string `json:"id"` // gov v1
 		Status     string `json:"status"`
+		TotalDeposit   []Coin `json:"total_deposit"`
+		DepositEndTime string `json:"deposit_end_time"`
 		Content    struct {
 			Type string `json:"@type"`
 		} `json:"content"`
@@ -296,7 +951,62 @@ type GovernanceProposalsResponse struct {
 
 func (c *Client) GetGovernanceProposals() (*GovernanceProposalsResponse, error) {
 	var res GovernanceProposalsResponse
-	err := c.get(c.apiURL+"/cosmos/gov/v1beta1/proposals", &res)
+	err := c.getVersioned("gov", func(version string) string {
+		return c.govPath(version) + "/proposals"
+	}, 0, &res)
+	for i := range res.Proposals {
+		if res.Proposals[i].ProposalID == "" {
+			res.Proposals[i].ProposalID = res.Proposals[i].ID
+		}
+	}
+	return &res, err
+}
+
+type GovTallyResponse struct {
+	Tally struct {
+		YesCount        string `json:"yes_count"`
+		AbstainCount    string `json:"abstain_count"`
+		NoCount         string `json:"no_count"`
+		NoWithVetoCount string `json:"no_with_veto_count"`
+	} `json:"tally"`
+}
+
+func (c *Client) GetProposalTally(proposalID string) (*GovTallyResponse, error) {
+	var res GovTallyResponse
+	err := c.getVersioned("gov", func(version string) string {
+		return c.govPath(version) + "/proposals/" + proposalID + "/tally"
+	}, 0, &res)
+	return &res, err
+}
+
+type GovTallyParamsResponse struct {
+	TallyParams struct {
+		Quorum        string `json:"quorum"`
+		Threshold     string `json:"threshold"`
+		VetoThreshold string `json:"veto_threshold"`
+	} `json:"tally_params"`
+}
+
+func (c *Client) GetGovTallyParams() (*GovTallyParamsResponse, error) {
+	var res GovTallyParamsResponse
+	err := c.getVersioned("gov", func(version string) string {
+		return c.govPath(version) + "/params/tallying"
+	}, c.cacheTTLs.Params, &res)
+	return &res, err
+}
+
+type GovDepositParamsResponse struct {
+	DepositParams struct {
+		MinDeposit       []Coin `json:"min_deposit"`
+		MaxDepositPeriod string `json:"max_deposit_period"`
+	} `json:"deposit_params"`
+}
+
+func (c *Client) GetGovDepositParams() (*GovDepositParamsResponse, error) {
+	var res GovDepositParamsResponse
+	err := c.getVersioned("gov", func(version string) string {
+		return c.govPath(version) + "/params/deposit"
+	}, c.cacheTTLs.Params, &res)
 	return &res, err
 }
 
@@ -312,7 +1022,64 @@ type SlashingParamsResponse struct {
 
 func (c *Client) GetSlashingParams() (*SlashingParamsResponse, error) {
 	var res SlashingParamsResponse
-	err := c.get(c.apiURL+"/cosmos/slashing/v1beta1/params", &res)
+	err := c.getCached(c.apiURL+c.modulePath("slashing")+"/params", c.cacheTTLs.Params, &res)
+	return &res, err
+}
+
+type IBCHeight struct {
+	RevisionNumber string `json:"revision_number"`
+	RevisionHeight string `json:"revision_height"`
+}
+
+type IBCClientStatesResponse struct {
+	ClientStates []struct {
+		ClientID    string `json:"client_id"`
+		ClientState struct {
+			Type           string    `json:"@type"`
+			ChainID        string    `json:"chain_id"`
+			TrustingPeriod string    `json:"trusting_period"`
+			FrozenHeight   IBCHeight `json:"frozen_height"`
+			LatestHeight   IBCHeight `json:"latest_height"`
+		} `json:"client_state"`
+	} `json:"client_states"`
+	Pagination Pagination `json:"pagination"`
+}
+
+func (c *Client) GetIBCClientStates() (*IBCClientStatesResponse, error) {
+	var res IBCClientStatesResponse
+	err := c.getAllPages(c.apiURL+c.modulePath("ibc_client")+"/client_states", 0, func(body []byte) (string, error) {
+		var page IBCClientStatesResponse
+		if err := decodeBody(body, &page); err != nil {
+			return "", err
+		}
+		res.ClientStates = append(res.ClientStates, page.ClientStates...)
+		return page.Pagination.NextKey, nil
+	})
+	return &res, err
+}
+
+type IBCConsensusStateResponse struct {
+	ConsensusState struct {
+		Timestamp string `json:"timestamp"`
+	} `json:"consensus_state"`
+}
+
+func (c *Client) GetIBCConsensusState(clientID string, height IBCHeight) (*IBCConsensusStateResponse, error) {
+	var res IBCConsensusStateResponse
+	url := fmt.Sprintf("%s%s/consensus_states/%s/revision/%s/height/%s", c.apiURL, c.modulePath("ibc_client"), clientID, height.RevisionNumber, height.RevisionHeight)
+	err := c.get(url, &res)
+	return &res, err
+}
+
+type IBCEscrowAddressResponse struct {
+	EscrowAddress string `json:"escrow_address"`
+}
+
+// GetIBCEscrowAddress returns the ICS-20 escrow account of a channel (deterministic, so cached like params)
+func (c *Client) GetIBCEscrowAddress(channelID, portID string) (*IBCEscrowAddressResponse, error) {
+	var res IBCEscrowAddressResponse
+	url := fmt.Sprintf("%s%s/channels/%s/ports/%s/escrow_address", c.apiURL, c.modulePath("ibc_transfer"), channelID, portID)
+	err := c.getCached(url, c.cacheTTLs.Params, &res)
 	return &res, err
 }
 
@@ -323,8 +1090,16 @@ type Coin struct {
 
 type StatusResponse struct {
 	Result struct {
+		NodeInfo struct {
+			ID      string `json:"id"`
+			Moniker string `json:"moniker"`
+			Network string `json:"network"`
+			Version string `json:"version"`
+		} `json:"node_info"`
 		SyncInfo struct {
-			LatestBlockHeight string `json:"latest_block_height"`
+			LatestBlockHeight   string `json:"latest_block_height"`
+			LatestBlockTime     string `json:"latest_block_time"`
+			EarliestBlockHeight string `json:"earliest_block_height"`
 		} `json:"sync_info"`
 	} `json:"result"`
 }
@@ -335,6 +1110,64 @@ func (c *Client) GetStatus() (*StatusResponse, error) {
 	return &res, err
 }
 
+// GetStatusFrom queries /status of another RPC endpoint of the chain (e.g. a failover node) with the client's
+// HTTP settings, bypassing the response cache and the circuit breakers of the configured endpoints
+func (c *Client) GetStatusFrom(rpcURL string) (*StatusResponse, error) {
+	res, err := c.doFetch(strings.TrimSuffix(rpcURL, "/")+"/status", "", "")
+	if err != nil {
+		return nil, err
+	}
+	var status StatusResponse
+	if err := decodeBody(res.body, &status); err != nil {
+		return nil, withEndpoint(err, rpcURL+"/status")
+	}
+	return &status, nil
+}
+
+type ConsensusStateResponse struct {
+	Result struct {
+		RoundState struct {
+			HeightRoundStep string `json:"height/round/step"`
+			StartTime       string `json:"start_time"`
+			HeightVoteSet   []struct {
+				Round              int    `json:"round"`
+				PrevotesBitArray   string `json:"prevotes_bit_array"`
+				PrecommitsBitArray string `json:"precommits_bit_array"`
+			} `json:"height_vote_set"`
+		} `json:"round_state"`
+	} `json:"result"`
+}
+
+// GetConsensusState returns the CometBFT round state (lighter than /dump_consensus_state)
+func (c *Client) GetConsensusState() (*ConsensusStateResponse, error) {
+	var res ConsensusStateResponse
+	err := c.get(c.rpcURL+"/consensus_state", &res)
+	return &res, err
+}
+
+type ABCIEvent struct {
+	Type       string `json:"type"`
+	Attributes []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"attributes"`
+}
+
+type BlockResultsResponse struct {
+	Result struct {
+		Height              string      `json:"height"`
+		BeginBlockEvents    []ABCIEvent `json:"begin_block_events"`
+		EndBlockEvents      []ABCIEvent `json:"end_block_events"`
+		FinalizeBlockEvents []ABCIEvent `json:"finalize_block_events"`
+	} `json:"result"`
+}
+
+func (c *Client) GetBlockResults(height int) (*BlockResultsResponse, error) {
+	var res BlockResultsResponse
+	err := c.getAtHeight(height, fmt.Sprintf("/block_results?height=%d", height), &res)
+	return &res, err
+}
+
 type BlockResponse struct {
 	Result struct {
 		Block struct {
@@ -356,16 +1189,69 @@ type BlockResponse struct {
 	} `json:"result"`
 }
 
+// GetBlock returns the block at height; height 0 means the latest block. A height the node does not have
+// (above latest or pruned) returns a *HeightNotAvailableError.
 func (c *Client) GetBlock(height int) (*BlockResponse, error) {
+	if height < 0 {
+		return nil, fmt.Errorf("invalid block height %d", height)
+	}
+
 	var res BlockResponse
-	url := c.rpcURL + "/block"
+	path := "/block"
 	if height > 0 {
-		url = fmt.Sprintf("%s?height=%d", url, height)
+		path = fmt.Sprintf("%s?height=%d", path, height)
 	}
-	err := c.get(url, &res)
-	return &res, err
+	if err := c.getAtHeight(height, path, &res); err != nil {
+		return nil, asHeightNotAvailable(err, int64(height))
+	}
+	return &res, nil
 }
 
 func (c *Client) GetLatestBlock() (*BlockResponse, error) {
 	return c.GetBlock(0)
-}
\ No newline at end of file
+}
+
+type ConsensusValidator struct {
+	Address string `json:"address"`
+	PubKey  struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	} `json:"pub_key"`
+	VotingPower      string `json:"voting_power"`
+	ProposerPriority string `json:"proposer_priority"`
+}
+
+type ConsensusValidatorsResponse struct {
+	Result struct {
+		BlockHeight string               `json:"block_height"`
+		Validators  []ConsensusValidator `json:"validators"`
+		Count       string               `json:"count"`
+		Total       string               `json:"total"`
+	} `json:"result"`
+}
+
+// GetConsensusValidators returns the full consensus validator set at height (0 = latest), following pagination
+func (c *Client) GetConsensusValidators(height int) ([]ConsensusValidator, error) {
+	const perPage = 100
+
+	var validators []ConsensusValidator
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("/validators?page=%d&per_page=%d", page, perPage)
+		if height > 0 {
+			path = fmt.Sprintf("%s&height=%d", path, height)
+		}
+
+		var res ConsensusValidatorsResponse
+		if err := c.getAtHeight(height, path, &res); err != nil {
+			return nil, err
+		}
+		validators = append(validators, res.Result.Validators...)
+
+		total, err := strconv.Atoi(res.Result.Total)
+		if err != nil || len(res.Result.Validators) == 0 || len(validators) >= total {
+			break
+		}
+	}
+
+	return validators, nil
+}
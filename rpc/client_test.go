@@ -0,0 +1,436 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"zerog-exporter/util"
+)
+
+func TestWithContextCancelsRequest(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+	client.SetCircuitBreaker(1, time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.WithContext(ctx).GetStatus()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetStatus error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("GetStatus returned after %v, want it to stop with the context", elapsed)
+	}
+
+	// 호출 측 context 취소는 endpoint 장애가 아님
+	if client.CircuitStates()["rpc"] {
+		t.Error("rpc circuit breaker opened by a canceled request")
+	}
+}
+
+// validatorsPage1 and validatorsPage2 are the two pages of a staking validators query (limit 2)
+const validatorsPage1 = `{
+  "validators": [
+    {
+      "operator_address": "0gvaloper1q9wf0rpdhxmqqcn5c8yh4v7ncn3dqfqh9g6vcz",
+      "consensus_pubkey": {"@type": "/cosmos.crypto.ed25519.PubKey", "key": "Jn9P6nB2e1H3cNfC2tzS9oO0OtpKzU0JhTtTJ3Yb5Cw="},
+      "jailed": false,
+      "status": "BOND_STATUS_BONDED",
+      "tokens": "5000000000000000000000000",
+      "delegator_shares": "5000000000000000000000000.000000000000000000",
+      "description": {"moniker": "alpha", "identity": "", "website": "https://alpha.example", "security_contact": "", "details": ""},
+      "unbonding_height": "0",
+      "unbonding_time": "1970-01-01T00:00:00Z",
+      "commission": {
+        "commission_rates": {"rate": "0.050000000000000000", "max_rate": "0.200000000000000000", "max_change_rate": "0.010000000000000000"},
+        "update_time": "2025-03-11T08:21:54.123456789Z"
+      },
+      "min_self_delegation": "1"
+    },
+    {
+      "operator_address": "0gvaloper1z4xjk9n7a8m4d6v0hl2y7w8e3t5r9q6p2s0ck7",
+      "consensus_pubkey": {"@type": "/cosmos.crypto.ed25519.PubKey", "key": "k3d0Qb5b1Yc2rUoN8XWJ8m2Mvq7zKXn1qkX2G1Y0pH0="},
+      "jailed": true,
+      "status": "BOND_STATUS_UNBONDING",
+      "tokens": "120000000000000000000",
+      "delegator_shares": "120000000000000000000.000000000000000000",
+      "description": {"moniker": "beta"},
+      "commission": {
+        "commission_rates": {"rate": "0.100000000000000000", "max_rate": "1.000000000000000000", "max_change_rate": "0.100000000000000000"},
+        "update_time": "2025-04-01T00:00:00Z"
+      },
+      "min_self_delegation": "1"
+    }
+  ],
+  "pagination": {"next_key": "FPx8xYtUZZ1c0yTj+6rt6A4j6Bkw/w==", "total": "0"}
+}`
+
+const validatorsPage2 = `{
+  "validators": [
+    {
+      "operator_address": "0gvaloper1m8k3u2rq5c4y7n0d6f9s1a3w5e7r9t2y4u6i8o",
+      "consensus_pubkey": {"@type": "/cosmos.crypto.ed25519.PubKey", "key": "b0lqZ8C4m2mR7y4Qx5uH2cN9dF3kL6pT1wS8eV0aZ5Y="},
+      "jailed": false,
+      "status": "BOND_STATUS_UNBONDED",
+      "tokens": "0",
+      "delegator_shares": "0.000000000000000000",
+      "description": {"moniker": "gamma"},
+      "commission": {
+        "commission_rates": {"rate": "0.000000000000000000", "max_rate": "0.100000000000000000", "max_change_rate": "0.010000000000000000"},
+        "update_time": "2025-05-20T12:00:00Z"
+      },
+      "min_self_delegation": "1"
+    }
+  ],
+  "pagination": {"next_key": null, "total": "0"}
+}`
+
+// newTestServer serves handler and returns a client whose RPC and REST API both point at it
+func newTestServer(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewClientWithHTTPClient(server.URL, server.URL, "", server.Client())
+}
+
+// validatorsHandler serves the two validators pages keyed by pagination.key and records the requested keys
+func validatorsHandler(t *testing.T, keys *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cosmos/staking/v1beta1/validators" {
+			http.NotFound(w, r)
+			return
+		}
+		key := r.URL.Query().Get("pagination.key")
+		*keys = append(*keys, key)
+		switch key {
+		case "":
+			fmt.Fprint(w, validatorsPage1)
+		case "FPx8xYtUZZ1c0yTj+6rt6A4j6Bkw/w==":
+			fmt.Fprint(w, validatorsPage2)
+		default:
+			t.Errorf("unexpected pagination.key %q", key)
+			http.Error(w, `{"code":3,"message":"invalid request"}`, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestGetValidatorsPagination(t *testing.T) {
+	var keys []string
+	client := newTestServer(t, validatorsHandler(t, &keys))
+	client.SetPageLimit(2)
+
+	res, err := client.GetValidators()
+	if err != nil {
+		t.Fatalf("GetValidators: %v", err)
+	}
+	if len(keys) != 2 || keys[1] != "FPx8xYtUZZ1c0yTj+6rt6A4j6Bkw/w==" {
+		t.Fatalf("requested pagination keys = %q, want the first page and next_key", keys)
+	}
+	if len(res.Validators) != 3 || res.Truncated {
+		t.Fatalf("validators = %d (truncated %v), want 3", len(res.Validators), res.Truncated)
+	}
+
+	alpha := res.Validators[0]
+	if alpha.OperatorAddress != "0gvaloper1q9wf0rpdhxmqqcn5c8yh4v7ncn3dqfqh9g6vcz" || alpha.Description.Moniker != "alpha" {
+		t.Errorf("validator 0 = %s / %s", alpha.OperatorAddress, alpha.Description.Moniker)
+	}
+	if alpha.ConsensusPubkey.Type != "/cosmos.crypto.ed25519.PubKey" || alpha.ConsensusPubkey.Key != "Jn9P6nB2e1H3cNfC2tzS9oO0OtpKzU0JhTtTJ3Yb5Cw=" {
+		t.Errorf("consensus_pubkey = %+v", alpha.ConsensusPubkey)
+	}
+	if alpha.Status != "BOND_STATUS_BONDED" || alpha.Jailed || alpha.Tokens != "5000000000000000000000000" {
+		t.Errorf("validator 0 status / jailed / tokens = %s / %v / %s", alpha.Status, alpha.Jailed, alpha.Tokens)
+	}
+	rates := alpha.Commission.CommissionRates
+	if rates.Rate != "0.050000000000000000" || rates.MaxRate != "0.200000000000000000" || rates.MaxChangeRate != "0.010000000000000000" {
+		t.Errorf("commission rates = %+v", rates)
+	}
+	if alpha.Commission.UpdateTime != "2025-03-11T08:21:54.123456789Z" {
+		t.Errorf("commission update_time = %s", alpha.Commission.UpdateTime)
+	}
+	if beta := res.Validators[1]; !beta.Jailed || beta.Status != "BOND_STATUS_UNBONDING" {
+		t.Errorf("validator 1 jailed / status = %v / %s", beta.Jailed, beta.Status)
+	}
+	if gamma := res.Validators[2]; gamma.Description.Moniker != "gamma" || gamma.Tokens != "0" {
+		t.Errorf("validator 2 = %s / %s", gamma.Description.Moniker, gamma.Tokens)
+	}
+
+	// 두 번째 호출은 캐시에서 응답
+	if _, err := client.GetValidators(); err != nil || len(keys) != 2 {
+		t.Errorf("cached GetValidators: err %v, %d requests, want 2", err, len(keys))
+	}
+}
+
+func TestGetValidatorsMaxValidators(t *testing.T) {
+	var keys []string
+	client := newTestServer(t, validatorsHandler(t, &keys))
+	client.SetPageLimit(2)
+	client.SetMaxValidators(2)
+
+	res, err := client.GetValidators()
+	if err != nil {
+		t.Fatalf("GetValidators: %v", err)
+	}
+	if len(res.Validators) != 2 || !res.Truncated {
+		t.Errorf("validators = %d (truncated %v), want 2 truncated", len(res.Validators), res.Truncated)
+	}
+	if len(keys) != 1 {
+		t.Errorf("requested %d pages, want 1", len(keys))
+	}
+}
+
+func TestGetStakingPool(t *testing.T) {
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cosmos/staking/v1beta1/pool" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"pool":{"not_bonded_tokens":"2530000000000000000000","bonded_tokens":"31245000000000000000000000"}}`)
+	})
+
+	pool, err := client.GetStakingPool()
+	if err != nil {
+		t.Fatalf("GetStakingPool: %v", err)
+	}
+	if pool.Pool.BondedTokens != "31245000000000000000000000" || pool.Pool.NotBondedTokens != "2530000000000000000000" {
+		t.Errorf("pool = %+v", pool.Pool)
+	}
+}
+
+func TestGetSigningInfosPagination(t *testing.T) {
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cosmos/slashing/v1beta1/signing_infos" {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.URL.Query().Get("pagination.key") {
+		case "":
+			fmt.Fprint(w, `{
+  "info": [
+    {"address": "0gvalcons1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn3xkpv3", "start_height": "0", "index_offset": "4817350", "jailed_until": "1970-01-01T00:00:00Z", "tombstoned": false, "missed_blocks_counter": "12"}
+  ],
+  "pagination": {"next_key": "FAAAAAAAAAAAAAAAAAAAAAAAAAAB", "total": "2"}
+}`)
+		case "FAAAAAAAAAAAAAAAAAAAAAAAAAAB":
+			fmt.Fprint(w, `{
+  "info": [
+    {"address": "0gvalcons1zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3a5xqwe", "start_height": "1520033", "index_offset": "3297317", "jailed_until": "2026-10-16T12:10:00.123456789Z", "tombstoned": true, "missed_blocks_counter": "500"}
+  ],
+  "pagination": {"next_key": null, "total": "0"}
+}`)
+		}
+	})
+
+	infos, err := client.GetSigningInfos()
+	if err != nil {
+		t.Fatalf("GetSigningInfos: %v", err)
+	}
+	if len(infos.Info) != 2 {
+		t.Fatalf("signing infos = %d, want 2", len(infos.Info))
+	}
+	first, second := infos.Info[0], infos.Info[1]
+	if first.IndexOffset != "4817350" || first.MissedBlocksCounter != "12" || first.Tombstoned {
+		t.Errorf("signing info 0 = %+v", first)
+	}
+	if second.StartHeight != "1520033" || second.JailedUntil != "2026-10-16T12:10:00.123456789Z" || !second.Tombstoned || second.MissedBlocksCounter != "500" {
+		t.Errorf("signing info 1 = %+v", second)
+	}
+}
+
+func TestGetWalletDelegationsPagination(t *testing.T) {
+	const address = "0g1fvsfjw7ysythang73guuan4g48zzcqkmujnpu6"
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cosmos/staking/v1beta1/delegations/"+address {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.URL.Query().Get("pagination.key") {
+		case "":
+			fmt.Fprint(w, `{
+  "delegation_responses": [
+    {"delegation": {"delegator_address": "`+address+`", "validator_address": "0gvaloper1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn8xq8n0", "shares": "1500000000000000000.000000000000000000"}, "balance": {"denom": "ua0gi", "amount": "1500000000000000000"}},
+    {"delegation": {"delegator_address": "`+address+`", "validator_address": "0gvaloper1zyg3zyg3zyg3zyg3zyg3zyg3zyg3zyg3ymdz0r", "shares": "2000000000000000000.000000000000000000"}, "balance": {"denom": "ua0gi", "amount": "2000000000000000000"}}
+  ],
+  "pagination": {"next_key": "FDH/2q0vMaZk3xBVC9j5fLhK3KAs", "total": "3"}
+}`)
+		case "FDH/2q0vMaZk3xBVC9j5fLhK3KAs":
+			fmt.Fprint(w, `{
+  "delegation_responses": [
+    {"delegation": {"delegator_address": "`+address+`", "validator_address": "0gvaloper1xvenxvenxvenxvenxvenxvenxvenxvenr3lx6e", "shares": "500000000000000000.000000000000000000"}, "balance": {"denom": "ua0gi", "amount": "500000000000000000"}}
+  ],
+  "pagination": {"next_key": null, "total": "0"}
+}`)
+		default:
+			http.Error(w, "unexpected pagination.key", http.StatusBadRequest)
+		}
+	})
+
+	delegations, err := client.GetWalletDelegations(address)
+	if err != nil {
+		t.Fatalf("GetWalletDelegations: %v", err)
+	}
+	if len(delegations.DelegationResponses) != 3 {
+		t.Fatalf("delegations = %d, want 3", len(delegations.DelegationResponses))
+	}
+	last := delegations.DelegationResponses[2]
+	if last.Delegation.ValidatorAddress != "0gvaloper1xvenxvenxvenxvenxvenxvenxvenxvenr3lx6e" || last.Balance.Amount != "500000000000000000" {
+		t.Errorf("delegation 2 = %+v", last)
+	}
+}
+
+// blockPayload is a CometBFT /block response whose last commit carries the given signature entries
+func blockPayload(height int64, signatures string) string {
+	return fmt.Sprintf(`{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "block_id": {"hash": "8D3B1F0C2E4A6B8D0F1E3C5A7B9D1F3E5C7A9B1D3F5E7C9A1B3D5F7E9C1A3B5D", "parts": {"total": 1, "hash": "0B1C2D3E4F5A6B7C8D9E0F1A2B3C4D5E6F7A8B9C0D1E2F3A4B5C6D7E8F9A0B1C"}},
+    "block": {
+      "header": {
+        "version": {"block": "11"},
+        "chain_id": "0g-galileo-testnet",
+        "height": "%d",
+        "time": "2026-10-16T11:59:58.412345678Z",
+        "proposer_address": "5E8A8F4C6B8D0E2F4A6C8E0B2D4F6A8C0E2B4D6F"
+      },
+      "data": {"txs": []},
+      "evidence": {"evidence": []},
+      "last_commit": {
+        "height": "%d",
+        "round": 0,
+        "block_id": {"hash": "F1E2D3C4B5A6978877665544332211000F1E2D3C4B5A69788776655443322110", "parts": {"total": 1, "hash": "00112233445566778899AABBCCDDEEFF00112233445566778899AABBCCDDEEFF"}},
+        "signatures": [%s]
+      }
+    }
+  }
+}`, height, height-1, signatures)
+}
+
+func TestGetBlockSignatureForms(t *testing.T) {
+	const hexAddr = "5E8A8F4C6B8D0E2F4A6C8E0B2D4F6A8C0E2B4D6F"
+	const base64Addr = "XoqPTGuNDi9KbI4LLU9qjA4rTW8=" // hexAddr base64 인코딩 (일부 endpoint 형식)
+
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("height") {
+		case "4817351":
+			fmt.Fprint(w, blockPayload(4817351, `
+          {"block_id_flag": 2, "validator_address": "`+hexAddr+`", "timestamp": "2026-10-16T11:59:58.9Z", "signature": "3q2+7wEjRWeJq83vASNFZ4mrze8BI0VniavN7wEjRWeJq83vASNFZ4mrze8BI0VniavN7wEjRWeJq83vASNFZw=="},
+          {"block_id_flag": 1, "validator_address": "", "timestamp": "0001-01-01T00:00:00Z", "signature": null}`))
+		case "4817352":
+			fmt.Fprint(w, blockPayload(4817352, `
+          {"block_id_flag": 2, "validator_address": "`+base64Addr+`", "timestamp": "2026-10-16T11:59:59.9Z", "signature": "3q2+7wEjRWeJq83vASNFZ4mrze8BI0VniavN7wEjRWeJq83vASNFZ4mrze8BI0VniavN7wEjRWeJq83vASNFZw=="}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	hexBlock, err := client.GetBlock(4817351)
+	if err != nil {
+		t.Fatalf("GetBlock(hex): %v", err)
+	}
+	header := hexBlock.Result.Block.Header
+	if header.Height != "4817351" || header.ChainID != "0g-galileo-testnet" || header.Time != "2026-10-16T11:59:58.412345678Z" || header.ProposerAddress != hexAddr {
+		t.Errorf("header = %+v", header)
+	}
+	signatures := hexBlock.Result.Block.LastCommit.Signatures
+	if len(signatures) != 2 || signatures[0].BlockIDFlag != 2 || signatures[1].BlockIDFlag != 1 || signatures[1].ValidatorAddress != "" {
+		t.Fatalf("signatures = %+v", signatures)
+	}
+
+	base64Block, err := client.GetBlock(4817352)
+	if err != nil {
+		t.Fatalf("GetBlock(base64): %v", err)
+	}
+	base64Sig := base64Block.Result.Block.LastCommit.Signatures[0].ValidatorAddress
+	if base64Sig != base64Addr {
+		t.Fatalf("base64 validator_address = %q", base64Sig)
+	}
+
+	// 두 형식 모두 같은 hex 주소로 정규화
+	for _, addr := range []string{signatures[0].ValidatorAddress, base64Sig} {
+		normalized, err := util.NormalizeConsensusAddress(addr)
+		if err != nil || normalized != hexAddr {
+			t.Errorf("NormalizeConsensusAddress(%q) = %q, %v, want %s", addr, normalized, err, hexAddr)
+		}
+	}
+}
+
+func TestGetBlockHeightNotAvailable(t *testing.T) {
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		switch r.URL.Query().Get("height") {
+		case "100":
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"height 100 is not available, lowest height is 3521000"}}`)
+		case "9999999":
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"height 9999999 must be less than or equal to the current blockchain height 4817352"}}`)
+		default:
+			fmt.Fprint(w, `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"leveldb: closed"}}`)
+		}
+	})
+
+	tests := []struct {
+		height       int
+		pruned       bool
+		lowestHeight int64
+	}{
+		{height: 100, pruned: true, lowestHeight: 3521000},
+		{height: 9999999, pruned: false},
+	}
+	for _, tt := range tests {
+		_, err := client.GetBlock(tt.height)
+		var heightErr *HeightNotAvailableError
+		if !errors.As(err, &heightErr) {
+			t.Fatalf("GetBlock(%d) error = %v, want *HeightNotAvailableError", tt.height, err)
+		}
+		if heightErr.Height != int64(tt.height) || heightErr.Pruned != tt.pruned || heightErr.LowestHeight != tt.lowestHeight {
+			t.Errorf("GetBlock(%d) = %+v", tt.height, heightErr)
+		}
+		if ErrorReason(err) != "height_not_available" {
+			t.Errorf("ErrorReason = %s, want height_not_available", ErrorReason(err))
+		}
+	}
+
+	// 다른 500 응답은 HTTPStatusError 그대로
+	_, err := client.GetBlock(200)
+	var heightErr *HeightNotAvailableError
+	var statusErr *HTTPStatusError
+	if errors.As(err, &heightErr) || !errors.As(err, &statusErr) || statusErr.Code != http.StatusInternalServerError {
+		t.Errorf("GetBlock(200) error = %v, want HTTP 500", err)
+	}
+}
+
+func TestGetBlockArchiveFallback(t *testing.T) {
+	primary := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, blockPayload(4817352, ""))
+	})
+	archive := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// archive가 아직 따라잡지 못한 height
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"jsonrpc":"2.0","id":-1,"error":{"code":-32603,"message":"Internal error","data":"height 4817352 must be less than or equal to the current blockchain height 4817350"}}`)
+	}))
+	defer archive.Close()
+	primary.SetArchiveRPC(archive.URL)
+
+	block, err := primary.GetBlock(4817352)
+	if err != nil {
+		t.Fatalf("GetBlock: %v", err)
+	}
+	if block.Result.Block.Header.Height != "4817352" {
+		t.Errorf("height = %s, want 4817352 from the primary", block.Result.Block.Header.Height)
+	}
+}
@@ -0,0 +1,163 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// HTTPStatusError is returned when an endpoint responds with a non-200 status
+type HTTPStatusError struct {
+	Code     int
+	Body     string
+	Endpoint string
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.Code, e.Body)
+}
+
+// DecodeError is returned when a response body cannot be unmarshalled
+type DecodeError struct {
+	Err      error
+	Endpoint string
+	Body     string
+}
+
+func (e *DecodeError) Error() string {
+	if e.Endpoint != "" {
+		return fmt.Sprintf("failed to decode response from %s: %v (body: %s)", e.Endpoint, e.Err, e.Body)
+	}
+	return fmt.Sprintf("failed to decode response: %v (body: %s)", e.Err, e.Body)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// ErrResponseTooLarge is returned when a response body exceeds the configured maximum size
+var ErrResponseTooLarge = errors.New("response body too large")
+
+// CircuitOpenError is returned without sending a request while the endpoint's circuit breaker is open
+type CircuitOpenError struct {
+	Endpoint string
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open for %s endpoint", e.Endpoint)
+}
+
+// IsCircuitOpen reports whether err was returned because the endpoint's circuit breaker is open
+func IsCircuitOpen(err error) bool {
+	var circuitErr *CircuitOpenError
+	return errors.As(err, &circuitErr)
+}
+
+// HeightNotAvailableError is returned when the node does not have the requested height, either because it is
+// above the latest height or because it was pruned (LowestHeight is the lowest retained height when reported)
+type HeightNotAvailableError struct {
+	Height       int64
+	LowestHeight int64
+	Pruned       bool
+	Message      string
+}
+
+func (e *HeightNotAvailableError) Error() string {
+	return fmt.Sprintf("height %d not available: %s", e.Height, e.Message)
+}
+
+// lowestHeightPattern matches the lowest retained height in CometBFT "height N is not available, lowest height is M"
+var lowestHeightPattern = regexp.MustCompile(`lowest height is (\d+)`)
+
+// asHeightNotAvailable converts a CometBFT "height not available" failure for height into a HeightNotAvailableError
+func asHeightNotAvailable(err error, height int64) error {
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) {
+		return err
+	}
+	if !strings.Contains(statusErr.Body, "is not available") && !strings.Contains(statusErr.Body, "must be less than or equal to") {
+		return err
+	}
+
+	heightErr := &HeightNotAvailableError{
+		Height:  height,
+		Pruned:  strings.Contains(statusErr.Body, "is not available"),
+		Message: statusErr.Body,
+	}
+	if match := lowestHeightPattern.FindStringSubmatch(statusErr.Body); match != nil {
+		heightErr.LowestHeight, _ = strconv.ParseInt(match[1], 10, 64)
+	}
+	return heightErr
+}
+
+// IsNotSupported reports whether err means the endpoint is not served by this chain (HTTP 404 or 501),
+// e.g. a module that is not enabled, as opposed to a node problem
+func IsNotSupported(err error) bool {
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code == http.StatusNotFound || statusErr.Code == http.StatusNotImplemented
+	}
+	return false
+}
+
+// withEndpoint records the requested url on a DecodeError
+func withEndpoint(err error, url string) error {
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) && decodeErr.Endpoint == "" {
+		decodeErr.Endpoint = url
+	}
+	return err
+}
+
+// ErrorReason classifies an error into a short, bounded reason (e.g. "timeout", "connection_refused", "http_404")
+// suitable as a metric label value; nil returns "none" and unrecognized errors "other"
+func ErrorReason(err error) string {
+	if err == nil {
+		return "none"
+	}
+
+	var statusErr *HTTPStatusError
+	var decodeErr *DecodeError
+	var heightErr *HeightNotAvailableError
+	var dnsErr *net.DNSError
+	var netErr net.Error
+	var tlsVerifyErr *tls.CertificateVerificationError
+	var tlsRecordErr tls.RecordHeaderError
+	switch {
+	case IsCircuitOpen(err):
+		return "circuit_open"
+	case errors.Is(err, ErrResponseTooLarge):
+		return "response_too_large"
+	case errors.As(err, &statusErr):
+		return "http_" + strconv.Itoa(statusErr.Code)
+	case errors.As(err, &heightErr):
+		return "height_not_available"
+	case errors.As(err, &decodeErr):
+		return "decode_error"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return "connection_refused"
+	case errors.Is(err, syscall.ECONNRESET):
+		return "connection_reset"
+	case errors.As(err, &dnsErr):
+		return "dns_error"
+	case errors.As(err, &tlsVerifyErr), errors.As(err, &tlsRecordErr):
+		return "tls_error"
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "timeout"
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return "connection_closed"
+	}
+	return "other"
+}
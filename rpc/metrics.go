@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RequestDuration records REST/RPC request latency by normalized endpoint and result
+var RequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "zerog_rpc_request_duration_seconds",
+	Help:    "Duration of REST/RPC requests to the node by endpoint",
+	Buckets: prometheus.DefBuckets,
+}, []string{"endpoint", "result"})
+
+var (
+	numericSegment = regexp.MustCompile(`^[0-9]+$`)
+	hexSegment     = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{32,}$`)
+	bech32Segment  = regexp.MustCompile(`^[a-z0-9]+1[02-9ac-hj-np-z]{38,}$`)
+)
+
+// normalizeEndpoint turns a request URL into a low-cardinality path template
+// (e.g. /cosmos/bank/v1beta1/balances/0g1abc... -> /cosmos/bank/v1beta1/balances/{address})
+func normalizeEndpoint(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "unknown"
+	}
+
+	segments := strings.Split(parsed.Path, "/")
+	for i, segment := range segments {
+		switch {
+		case numericSegment.MatchString(segment):
+			segments[i] = "{id}"
+		case bech32Segment.MatchString(segment):
+			segments[i] = "{address}"
+		case hexSegment.MatchString(segment):
+			segments[i] = "{hash}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
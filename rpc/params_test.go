@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetDistributionParams(t *testing.T) {
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cosmos/distribution/v1beta1/params" {
+			http.NotFound(w, r)
+			return
+		}
+		// SDK 0.47+: base / bonus proposer reward은 deprecated (0)
+		fmt.Fprint(w, `{"params":{"community_tax":"0.020000000000000000","base_proposer_reward":"0.000000000000000000","bonus_proposer_reward":"0.000000000000000000","withdraw_addr_enabled":true}}`)
+	})
+
+	params, err := client.GetDistributionParams()
+	if err != nil {
+		t.Fatalf("GetDistributionParams: %v", err)
+	}
+	if params.Params.CommunityTax != "0.020000000000000000" {
+		t.Errorf("community_tax = %q", params.Params.CommunityTax)
+	}
+	if !params.Params.WithdrawAddrEnabled {
+		t.Error("withdraw_addr_enabled = false, want true")
+	}
+	if params.Params.BaseProposerReward != "0.000000000000000000" || params.Params.BonusProposerReward != "0.000000000000000000" {
+		t.Errorf("proposer rewards = %q / %q", params.Params.BaseProposerReward, params.Params.BonusProposerReward)
+	}
+}
+
+// slashingParamsPayload is a /cosmos/slashing/v1beta1/params response in the format a 0G node's REST API returns
+const slashingParamsPayload = `{
+  "params": {
+    "signed_blocks_window": "10000",
+    "min_signed_per_window": "0.050000000000000000",
+    "downtime_jail_duration": "600s",
+    "slash_fraction_double_sign": "0.050000000000000000",
+    "slash_fraction_downtime": "0.000100000000000000"
+  }
+}`
+
+func TestGetSlashingParams(t *testing.T) {
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cosmos/slashing/v1beta1/params" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, slashingParamsPayload)
+	})
+
+	params, err := client.GetSlashingParams()
+	if err != nil {
+		t.Fatalf("GetSlashingParams: %v", err)
+	}
+	got := params.Params
+	if got.SignedBlocksWindow != "10000" || got.MinSignedPerWindow != "0.050000000000000000" || got.DowntimeJailDuration != "600s" {
+		t.Errorf("slashing params = %+v", got)
+	}
+	if got.SlashFractionDoubleSign != "0.050000000000000000" || got.SlashFractionDowntime != "0.000100000000000000" {
+		t.Errorf("slash fractions = %q / %q", got.SlashFractionDoubleSign, got.SlashFractionDowntime)
+	}
+}
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultModulePaths are the standard Cosmos SDK REST base paths of each module. Chains with renamed or
+// namespaced routes override them per module with SetModulePaths. gov is versioned: its default base is
+// /cosmos/gov/v1 with /cosmos/gov/v1beta1 as fallback, and an override replaces both.
+var DefaultModulePaths = map[string]string{
+	"bank":         "/cosmos/bank/v1beta1",
+	"staking":      "/cosmos/staking/v1beta1",
+	"slashing":     "/cosmos/slashing/v1beta1",
+	"distribution": "/cosmos/distribution/v1beta1",
+	"mint":         "/cosmos/mint/v1beta1",
+	"gov":          "/cosmos/gov/{version}",
+	"tendermint":   "/cosmos/base/tendermint/v1beta1",
+	"ibc_client":   "/ibc/core/client/v1",
+	"ibc_transfer": "/ibc/apps/transfer/v1",
+}
+
+// SetModulePaths overrides the REST base path of modules (module name -> base path, e.g. "mint" ->
+// "/custom/mint"); modules without an override keep DefaultModulePaths. Unknown module names are rejected.
+func (c *Client) SetModulePaths(overrides map[string]string) error {
+	paths := make(map[string]string, len(overrides))
+	var unknown []string
+	for module, path := range overrides {
+		if _, ok := DefaultModulePaths[module]; !ok {
+			unknown = append(unknown, module)
+			continue
+		}
+		if path != "" {
+			paths[module] = strings.TrimSuffix(path, "/")
+		}
+	}
+	c.modulePaths = paths
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown modules in rest_paths: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// modulePath returns the REST base path of module, the override when one is configured
+func (c *Client) modulePath(module string) string {
+	if path, ok := c.modulePaths[module]; ok {
+		return path
+	}
+	return DefaultModulePaths[module]
+}
+
+// govPath returns the REST base path of the gov module for an API version
+func (c *Client) govPath(version string) string {
+	if path, ok := c.modulePaths["gov"]; ok {
+		return path
+	}
+	return "/cosmos/gov/" + version
+}
@@ -0,0 +1,54 @@
+package rpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// NewTLSHTTPClient creates the default http.Client with a TLS config that trusts caFile (PEM) in addition to
+// the system roots and optionally skips certificate verification for self-signed endpoints
+func NewTLSHTTPClient(insecureSkipVerify bool, caFile string) (*http.Client, error) {
+	return NewMTLSHTTPClient(insecureSkipVerify, caFile, "", "")
+}
+
+// NewMTLSHTTPClient is NewTLSHTTPClient that additionally presents the client certificate certFile / keyFile (PEM)
+// to endpoints requiring mutual TLS; empty cert and key files disable the client certificate
+func NewMTLSHTTPClient(insecureSkipVerify bool, caFile, certFile, keyFile string) (*http.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecureSkipVerify}
+
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_file: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in ca_file %s", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package util
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// abiWordSize is the size of an ABI-encoded word in bytes
+const abiWordSize = 32
+
+// FunctionSelector returns the 4-byte selector of a Solidity function signature (e.g. "getValidators()")
+// as 0x-prefixed hex
+func FunctionSelector(signature string) string {
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(signature))
+	return "0x" + hex.EncodeToString(hash.Sum(nil)[:4])
+}
+
+// DecodeAddressArray decodes an eth_call result holding a single dynamic address[] return value
+// (offset word, length word, then one left-padded address per word) into lowercase 0x-prefixed addresses
+func DecodeAddressArray(result string) ([]string, error) {
+	data, err := hex.DecodeString(StripHexPrefix(result))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ABI data: %w", err)
+	}
+
+	offset, err := abiWordAt(data, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read array offset: %w", err)
+	}
+	length, err := abiWordAt(data, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read array length: %w", err)
+	}
+
+	start := offset + abiWordSize
+	if length > uint64(len(data)-int(start))/abiWordSize {
+		return nil, fmt.Errorf("array length %d exceeds data size %d", length, len(data))
+	}
+
+	addresses := make([]string, 0, length)
+	for i := uint64(0); i < length; i++ {
+		word := data[start+i*abiWordSize : start+(i+1)*abiWordSize]
+		addresses = append(addresses, "0x"+hex.EncodeToString(word[12:]))
+	}
+	return addresses, nil
+}
+
+// DecodeAddress decodes an eth_call result holding a single address return value
+func DecodeAddress(result string) (string, error) {
+	data, err := hex.DecodeString(StripHexPrefix(result))
+	if err != nil {
+		return "", fmt.Errorf("invalid ABI data: %w", err)
+	}
+	if len(data) < abiWordSize {
+		return "", fmt.Errorf("address result too short: %d bytes", len(data))
+	}
+	return "0x" + hex.EncodeToString(data[12:abiWordSize]), nil
+}
+
+// EncodeBytes ABI-encodes hex data (without 0x) as the single dynamic bytes argument of a call:
+// offset word, length word, then the data right-padded to a multiple of 32 bytes
+func EncodeBytes(data string) string {
+	length := len(data) / 2
+	padded := data
+	if rem := length % abiWordSize; rem != 0 {
+		padded += strings.Repeat("00", abiWordSize-rem)
+	}
+	return fmt.Sprintf("%064x%064x%s", abiWordSize, length, padded)
+}
+
+// abiWordAt reads the 32-byte word at byte position pos as an unsigned integer
+func abiWordAt(data []byte, pos uint64) (uint64, error) {
+	if pos > uint64(len(data)) || uint64(len(data))-pos < abiWordSize {
+		return 0, fmt.Errorf("word at %d out of range (data size %d)", pos, len(data))
+	}
+	value := new(big.Int).SetBytes(data[pos : pos+abiWordSize])
+	if !value.IsUint64() {
+		return 0, fmt.Errorf("word at %d too large: %s", pos, value)
+	}
+	return value.Uint64(), nil
+}
@@ -0,0 +1,116 @@
+package util
+
+import (
+	"strings"
+	"testing"
+)
+
+// abiWord left-pads hex (without 0x) to a 32-byte word
+func abiWord(value string) string {
+	return strings.Repeat("0", 64-len(value)) + value
+}
+
+func TestFunctionSelector(t *testing.T) {
+	if got := FunctionSelector("transfer(address,uint256)"); got != "0xa9059cbb" {
+		t.Errorf("FunctionSelector(transfer) = %s, want 0xa9059cbb", got)
+	}
+	if got := FunctionSelector("balanceOf(address)"); got != "0x70a08231" {
+		t.Errorf("FunctionSelector(balanceOf) = %s, want 0x70a08231", got)
+	}
+}
+
+func TestDecodeAddressArray(t *testing.T) {
+	first := "4b20993bc481177ec7e8f571cecae8a9e22c02db"
+	second := "7e5f4552091a69125d5dfcb7b8c2659029395bdf"
+
+	tests := []struct {
+		name   string
+		result string
+		want   []string
+	}{
+		{
+			name:   "two addresses",
+			result: "0x" + abiWord("20") + abiWord("2") + abiWord(first) + abiWord(second),
+			want:   []string{"0x" + first, "0x" + second},
+		},
+		{
+			name:   "uppercase without 0x",
+			result: strings.ToUpper(abiWord("20") + abiWord("1") + abiWord(first)),
+			want:   []string{"0x" + first},
+		},
+		{
+			name:   "empty array",
+			result: "0x" + abiWord("20") + abiWord("0"),
+			want:   []string{},
+		},
+		{
+			// offset이 0x20이 아닌 경우 (앞에 다른 word가 있음)
+			name:   "non-standard offset",
+			result: "0x" + abiWord("40") + abiWord("ff") + abiWord("1") + abiWord(second),
+			want:   []string{"0x" + second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeAddressArray(tt.result)
+			if err != nil {
+				t.Fatalf("DecodeAddressArray: %v", err)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("DecodeAddressArray = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeAddressArrayInvalid(t *testing.T) {
+	address := abiWord("4b20993bc481177ec7e8f571cecae8a9e22c02db")
+	tests := []struct {
+		name   string
+		result string
+	}{
+		{name: "empty", result: "0x"},
+		{name: "not hex", result: "0xzz"},
+		{name: "missing length", result: "0x" + abiWord("20")},
+		{name: "offset out of range", result: "0x" + abiWord("1000") + abiWord("1")},
+		{name: "truncated array", result: "0x" + abiWord("20") + abiWord("2") + address},
+		{name: "huge length", result: "0x" + abiWord("20") + strings.Repeat("f", 64) + address},
+		{name: "huge offset", result: "0x" + strings.Repeat("f", 64) + abiWord("1") + address},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := DecodeAddressArray(tt.result); err == nil {
+				t.Errorf("DecodeAddressArray = %v, want an error", got)
+			}
+		})
+	}
+}
+
+func TestDecodeAddress(t *testing.T) {
+	got, err := DecodeAddress("0x" + abiWord("4B20993Bc481177ec7E8f571ceCaE8A9e22C02db"))
+	if err != nil {
+		t.Fatalf("DecodeAddress: %v", err)
+	}
+	if got != "0x4b20993bc481177ec7e8f571cecae8a9e22c02db" {
+		t.Errorf("DecodeAddress = %s, want 0x4b20993bc481177ec7e8f571cecae8a9e22c02db", got)
+	}
+
+	for _, result := range []string{"0x", "0x4b20993bc481177ec7e8f571cecae8a9e22c02db", "0xzz"} {
+		if got, err := DecodeAddress(result); err == nil {
+			t.Errorf("DecodeAddress(%q) = %s, want an error", result, got)
+		}
+	}
+}
+
+func TestEncodeBytes(t *testing.T) {
+	pubkey := "02a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02ee0be551b5dc" // 33 bytes
+	want := abiWord("20") + abiWord("21") + pubkey + strings.Repeat("00", 31)
+	if got := EncodeBytes(pubkey); got != want {
+		t.Errorf("EncodeBytes = %s, want %s", got, want)
+	}
+
+	word := strings.Repeat("ab", 32)
+	if got := EncodeBytes(word); got != abiWord("20")+abiWord("20")+word {
+		t.Errorf("EncodeBytes(32 bytes) = %s, want no padding", got)
+	}
+}
@@ -4,7 +4,11 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"math/big"
 	"strings"
+
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
 )
 
 // GenerateConsensusAddressFromPubkey는 base64로 인코딩된 consensus pubkey에서
@@ -20,3 +24,73 @@ func GenerateConsensusAddressFromPubkey(pubKeyBase64 string) string {
 	
 	return strings.ToUpper(hex.EncodeToString(consensusAddr20))
 }
+
+// GenerateConsensusAddressFromTypedPubkey는 pubkey 타입(type URL 또는 amino 타입)에 맞는 방식으로
+// consensus address를 생성합니다
+//   - ethsecp256k1: keccak256(uncompressed pubkey)의 뒤 20바이트 (EVM 주소와 동일)
+//   - secp256k1: RIPEMD160(SHA256(pubkey))
+//   - 그 외 (ed25519): SHA256 해시의 앞 20바이트
+func GenerateConsensusAddressFromTypedPubkey(keyType, pubKeyBase64 string) string {
+	keyType = strings.ToLower(keyType)
+	switch {
+	case strings.Contains(keyType, "ethsecp256k1"):
+		pubKeyBytes, err := base64.StdEncoding.DecodeString(pubKeyBase64)
+		if err != nil {
+			return ""
+		}
+		uncompressed := decompressSecp256k1(pubKeyBytes)
+		if uncompressed == nil {
+			return ""
+		}
+		hash := sha3.NewLegacyKeccak256()
+		hash.Write(uncompressed[1:])
+		return strings.ToUpper(hex.EncodeToString(hash.Sum(nil)[12:]))
+	case strings.Contains(keyType, "secp256k1"):
+		pubKeyBytes, err := base64.StdEncoding.DecodeString(pubKeyBase64)
+		if err != nil {
+			return ""
+		}
+		sha256Hash := sha256.Sum256(pubKeyBytes)
+		hash := ripemd160.New()
+		hash.Write(sha256Hash[:])
+		return strings.ToUpper(hex.EncodeToString(hash.Sum(nil)))
+	default:
+		return GenerateConsensusAddressFromPubkey(pubKeyBase64)
+	}
+}
+
+// secp256k1 curve: y^2 = x^3 + 7 (mod p)
+var secp256k1P, _ = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F", 16)
+
+// decompressSecp256k1 returns the 65-byte uncompressed form of a 33-byte compressed secp256k1 pubkey
+// (uncompressed keys are returned as is, nil when the key is invalid)
+func decompressSecp256k1(pubKey []byte) []byte {
+	if len(pubKey) == 65 && pubKey[0] == 0x04 {
+		return pubKey
+	}
+	if len(pubKey) != 33 || (pubKey[0] != 0x02 && pubKey[0] != 0x03) {
+		return nil
+	}
+
+	x := new(big.Int).SetBytes(pubKey[1:])
+	ySquared := new(big.Int).Exp(x, big.NewInt(3), secp256k1P)
+	ySquared.Add(ySquared, big.NewInt(7))
+	ySquared.Mod(ySquared, secp256k1P)
+
+	// p ≡ 3 (mod 4) 이므로 sqrt(a) = a^((p+1)/4)
+	exponent := new(big.Int).Add(secp256k1P, big.NewInt(1))
+	exponent.Rsh(exponent, 2)
+	y := new(big.Int).Exp(ySquared, exponent, secp256k1P)
+	if new(big.Int).Exp(y, big.NewInt(2), secp256k1P).Cmp(ySquared) != 0 {
+		return nil
+	}
+	if y.Bit(0) != uint(pubKey[0]&1) {
+		y.Sub(secp256k1P, y)
+	}
+
+	uncompressed := make([]byte, 65)
+	uncompressed[0] = 0x04
+	x.FillBytes(uncompressed[1:33])
+	y.FillBytes(uncompressed[33:])
+	return uncompressed
+}
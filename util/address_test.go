@@ -0,0 +1,100 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// pubkeyBase64 base64-encodes a hex pubkey the way the staking API returns consensus_pubkey.key
+func pubkeyBase64(t *testing.T, pubKeyHex string) string {
+	t.Helper()
+	raw, err := hex.DecodeString(pubKeyHex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+func TestGenerateConsensusAddressEthSecp256k1(t *testing.T) {
+	// private key 1, 2, 3에 해당하는 pubkey와 EVM 주소
+	tests := []struct {
+		name    string
+		pubKey  string
+		address string
+	}{
+		{name: "compressed 1*G", pubKey: "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", address: "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"},
+		{name: "compressed 2*G", pubKey: "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5", address: "0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF"},
+		{name: "compressed 3*G", pubKey: "02f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9", address: "0x6813Eb9362372EEF6200f3b1dbC3f819671cBA69"},
+		{name: "uncompressed 1*G", pubKey: "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8", address: "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := strings.ToUpper(StripHexPrefix(tt.address))
+			for _, keyType := range []string{"/cosmos.evm.crypto.v1.ethsecp256k1.PubKey", "/ethermint.crypto.v1.ethsecp256k1.PubKey", "ethermint/PubKeyEthSecp256k1"} {
+				got := GenerateConsensusAddressFromTypedPubkey(keyType, pubkeyBase64(t, tt.pubKey))
+				if got != want {
+					t.Errorf("GenerateConsensusAddressFromTypedPubkey(%q) = %s, want %s", keyType, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecompressSecp256k1OddY(t *testing.T) {
+	// -G: x는 G와 같고 y = p - y(G) (홀수)
+	even := decompressSecp256k1(mustDecodeHex(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"))
+	odd := decompressSecp256k1(mustDecodeHex(t, "0379be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"))
+	if even == nil || odd == nil {
+		t.Fatal("decompressSecp256k1 returned nil for a valid key")
+	}
+	if hex.EncodeToString(odd[1:33]) != hex.EncodeToString(even[1:33]) {
+		t.Errorf("x differs: %x vs %x", odd[1:33], even[1:33])
+	}
+	if odd[64]&1 != 1 || even[64]&1 != 0 {
+		t.Errorf("y parity: 0x02 key -> %d, 0x03 key -> %d", even[64]&1, odd[64]&1)
+	}
+
+	// curve 위에 없는 x (x = 5는 y^2 = 132가 quadratic residue가 아님)
+	invalid := mustDecodeHex(t, "020000000000000000000000000000000000000000000000000000000000000005")
+	if got := decompressSecp256k1(invalid); got != nil {
+		t.Errorf("decompressSecp256k1(off-curve) = %x, want nil", got)
+	}
+	if got := decompressSecp256k1([]byte{0x02, 0x01}); got != nil {
+		t.Errorf("decompressSecp256k1(short) = %x, want nil", got)
+	}
+}
+
+func TestGenerateConsensusAddressOtherKeyTypes(t *testing.T) {
+	pubKey := pubkeyBase64(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+
+	// secp256k1: RIPEMD160(SHA256(pubkey)) - bitcoin hash160
+	if got := GenerateConsensusAddressFromTypedPubkey("/cosmos.crypto.secp256k1.PubKey", pubKey); got != "751E76E8199196D454941C45D1B3A323F1433BD6" {
+		t.Errorf("secp256k1 address = %s, want 751E76E8199196D454941C45D1B3A323F1433BD6", got)
+	}
+
+	ed25519Key := "Ux+SBlPgzKLAYLEJeHy8DSx1uX9DSE/EWLq34L7t4ac="
+	want := GenerateConsensusAddressFromPubkey(ed25519Key)
+	if want == "" || len(want) != 40 {
+		t.Fatalf("GenerateConsensusAddressFromPubkey = %q, want 40 hex chars", want)
+	}
+	for _, keyType := range []string{"/cosmos.crypto.ed25519.PubKey", "tendermint/PubKeyEd25519", ""} {
+		if got := GenerateConsensusAddressFromTypedPubkey(keyType, ed25519Key); got != want {
+			t.Errorf("GenerateConsensusAddressFromTypedPubkey(%q) = %s, want %s", keyType, got, want)
+		}
+	}
+
+	if got := GenerateConsensusAddressFromTypedPubkey("/cosmos.evm.crypto.v1.ethsecp256k1.PubKey", "not base64!"); got != "" {
+		t.Errorf("invalid base64 = %q, want \"\"", got)
+	}
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	raw, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
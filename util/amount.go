@@ -0,0 +1,41 @@
+package util
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// amountPrecision is the big.Float mantissa precision used for amount arithmetic (well beyond 78-digit uint256 values)
+const amountPrecision = 512
+
+// ParseAmount parses a base-unit amount string (integer or decimal, e.g. "1000000" or "123.450000000000000000")
+func ParseAmount(raw string) (*big.Float, error) {
+	amount, ok := new(big.Float).SetPrec(amountPrecision).SetString(strings.TrimSpace(raw))
+	if !ok {
+		return nil, fmt.Errorf("invalid amount: %q", raw)
+	}
+	return amount, nil
+}
+
+// ScaleAmount converts a base-unit amount string to display units by dividing by 10^decimals.
+// The division is done with big.Float so amounts beyond int64 keep float64 precision.
+func ScaleAmount(raw string, decimals int) (float64, error) {
+	amount, err := ParseAmount(raw)
+	if err != nil {
+		return 0, err
+	}
+	return ScaleBigAmount(amount, decimals), nil
+}
+
+// ScaleBigAmount divides a base-unit amount by 10^decimals and returns it as float64
+func ScaleBigAmount(amount *big.Float, decimals int) float64 {
+	value := new(big.Float).SetPrec(amountPrecision).Set(amount)
+	if decimals > 0 {
+		divisor := new(big.Float).SetPrec(amountPrecision).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+		value.Quo(value, divisor)
+	}
+
+	result, _ := value.Float64()
+	return result
+}
@@ -0,0 +1,68 @@
+package util
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestScaleAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		decimals int
+		want     float64
+	}{
+		{name: "decimals 0", raw: "1234567", decimals: 0, want: 1234567},
+		{name: "decimals 6", raw: "1500000", decimals: 6, want: 1.5},
+		{name: "decimals 18", raw: "1500000000000000000", decimals: 18, want: 1.5},
+		{name: "2^63", raw: "9223372036854775808", decimals: 0, want: 9223372036854775808},
+		{name: "2^63 decimals 18", raw: "9223372036854775808", decimals: 18, want: 9.223372036854775808},
+		{name: "10^30 ua0gi", raw: "1000000000000000000000000000000", decimals: 18, want: 1e12},
+		{name: "10^30 ua0gi decimals 6", raw: "1000000000000000000000000000000", decimals: 6, want: 1e24},
+		{name: "sdk.Dec", raw: "123.450000000000000000", decimals: 6, want: 0.00012345},
+		{name: "surrounding whitespace", raw: " 42 ", decimals: 0, want: 42},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ScaleAmount(tt.raw, tt.decimals)
+			if err != nil {
+				t.Fatalf("ScaleAmount(%q, %d): %v", tt.raw, tt.decimals, err)
+			}
+			if math.Abs(got-tt.want) > math.Abs(tt.want)*1e-15 {
+				t.Errorf("ScaleAmount(%q, %d) = %v, want %v", tt.raw, tt.decimals, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScaleAmountInvalid(t *testing.T) {
+	for _, raw := range []string{"", "abc", "1e", "12,5"} {
+		if _, err := ScaleAmount(raw, 6); err == nil {
+			t.Errorf("ScaleAmount(%q) succeeded, want an error", raw)
+		}
+	}
+}
+
+func TestScaleBigAmount(t *testing.T) {
+	// 2^63 - int64 범위를 넘는 값
+	amount := new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 63))
+
+	if got := ScaleBigAmount(amount, 0); got != math.Pow(2, 63) {
+		t.Errorf("ScaleBigAmount(2^63, 0) = %v, want %v", got, math.Pow(2, 63))
+	}
+	if got, want := ScaleBigAmount(amount, 6), math.Pow(2, 63)/1e6; math.Abs(got-want) > want*1e-15 {
+		t.Errorf("ScaleBigAmount(2^63, 6) = %v, want %v", got, want)
+	}
+
+	// 입력 값은 변경하지 않음
+	if amount.Cmp(new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 63))) != 0 {
+		t.Errorf("ScaleBigAmount modified its input: %v", amount)
+	}
+
+	// 10^30 ua0gi (uint256 규모 공급량)
+	supply, _ := new(big.Float).SetPrec(amountPrecision).SetString("1000000000000000000000000000000")
+	if got := ScaleBigAmount(supply, 18); got != 1e12 {
+		t.Errorf("ScaleBigAmount(10^30, 18) = %v, want 1e12", got)
+	}
+}
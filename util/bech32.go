@@ -1,6 +1,7 @@
 package util
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"strconv"
@@ -49,6 +50,21 @@ func ConvertAddress(address, fromPrefix, toPrefix string) (string, error) {
 	return newAddress, nil
 }
 
+// ConsensusAddressToHex converts a bech32 consensus address (e.g. valcons1...) to its uppercase hex form
+func ConsensusAddressToHex(address string) (string, error) {
+	_, data, err := bech32.Decode(address)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode bech32 address: %w", err)
+	}
+
+	converted, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to convert address bits: %w", err)
+	}
+
+	return strings.ToUpper(hex.EncodeToString(converted)), nil
+}
+
 func GetConsensusHexFromPubKeyString(pubKeyStr string) (string, error) {
 	if !strings.HasPrefix(pubKeyStr, "{\"@type\":\"") {
 		return "", fmt.Errorf("invalid pubkey format")
@@ -98,3 +114,33 @@ func ConvertTokenAmount(amount string, fromDecimals, toDecimals int) string {
 
 	return fmt.Sprintf("%.6f", amountFloat)
 }
+
+// ValidateBech32Address checks that address is a valid bech32 string with the expected human-readable prefix
+func ValidateBech32Address(address, prefix string) error {
+	hrp, _, err := bech32.Decode(address)
+	if err != nil {
+		return fmt.Errorf("failed to decode bech32 address: %w", err)
+	}
+	if hrp != prefix {
+		return fmt.Errorf("address prefix mismatch: expected %s, got %s", prefix, hrp)
+	}
+	return nil
+}
+
+// NormalizeConsensusAddress returns a consensus address in its canonical uppercase hex form. Block signatures,
+// /validators and the REST API encode it as hex, base64 or bech32 (valcons...) depending on the endpoint
+func NormalizeConsensusAddress(address string) (string, error) {
+	address = strings.TrimSpace(address)
+	if raw := StripHexPrefix(address); len(raw) == 40 {
+		if _, err := hex.DecodeString(raw); err == nil {
+			return strings.ToUpper(raw), nil
+		}
+	}
+	if hexAddr, err := ConsensusAddressToHex(address); err == nil && len(hexAddr) == 40 {
+		return hexAddr, nil
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(address); err == nil && len(decoded) == 20 {
+		return strings.ToUpper(hex.EncodeToString(decoded)), nil
+	}
+	return "", fmt.Errorf("invalid consensus address %q: expected 20-byte hex, base64 or bech32", address)
+}
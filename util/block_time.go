@@ -3,14 +3,21 @@ package util
 import (
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 )
 
+// BlockTimeCalculator is safe for concurrent use; getters also evict stale samples
 type BlockTimeCalculator struct {
+	mu               sync.Mutex
 	lastBlockTime    time.Time
 	lastBlockHeight  int64
 	blockTimeHistory []time.Duration
+	sampleTimes      []time.Time // blockTimeHistory 각 항목의 블록 시간 (max age eviction용)
 	maxHistorySize   int
+	maxAge           time.Duration
+	emaAlpha         float64
+	ema              time.Duration // exponential moving average (0 = 샘플 없음)
 }
 
 func NewBlockTimeCalculator(maxHistorySize int) *BlockTimeCalculator {
@@ -19,25 +26,100 @@ func NewBlockTimeCalculator(maxHistorySize int) *BlockTimeCalculator {
 	}
 	return &BlockTimeCalculator{
 		blockTimeHistory: make([]time.Duration, 0, maxHistorySize),
+		sampleTimes:      make([]time.Time, 0, maxHistorySize),
 		maxHistorySize:   maxHistorySize,
+		emaAlpha:         DefaultEMAAlpha,
 	}
 }
 
+// DefaultEMAAlpha is the weight of the newest sample in the exponential moving average
+const DefaultEMAAlpha = 0.1
+
+// SetEMAAlpha sets the weight (0 < alpha <= 1) of the newest sample in the EMA; higher values react faster.
+// Out of range values are ignored
+func (btc *BlockTimeCalculator) SetEMAAlpha(alpha float64) {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
+	if alpha > 0 && alpha <= 1 {
+		btc.emaAlpha = alpha
+	}
+}
+
+// SetMaxAge drops samples whose block is older than maxAge regardless of the history size (0 = no age limit)
+func (btc *BlockTimeCalculator) SetMaxAge(maxAge time.Duration) {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
+	btc.maxAge = maxAge
+}
+
+// UpdateBlockTime records the header time of the block at height. When several blocks were produced since the
+// previous update, the time difference is spread evenly over them.
 func (btc *BlockTimeCalculator) UpdateBlockTime(height int64, blockTime time.Time) {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
+
 	if btc.lastBlockHeight > 0 && height > btc.lastBlockHeight {
-		timeDiff := blockTime.Sub(btc.lastBlockTime)
+		timeDiff := blockTime.Sub(btc.lastBlockTime) / time.Duration(height-btc.lastBlockHeight)
 		btc.blockTimeHistory = append(btc.blockTimeHistory, timeDiff)
+		btc.sampleTimes = append(btc.sampleTimes, blockTime)
+		btc.updateEMA(timeDiff)
 		
 		if len(btc.blockTimeHistory) > btc.maxHistorySize {
 			btc.blockTimeHistory = btc.blockTimeHistory[1:]
+			btc.sampleTimes = btc.sampleTimes[1:]
 		}
 	}
 	
 	btc.lastBlockTime = blockTime
 	btc.lastBlockHeight = height
+	btc.evictStale()
+}
+
+// evictStale removes samples older than maxAge (samples are ordered by block time). Callers hold btc.mu.
+func (btc *BlockTimeCalculator) evictStale() {
+	if btc.maxAge <= 0 {
+		return
+	}
+
+	cutoff := time.Now().Add(-btc.maxAge)
+	stale := 0
+	for stale < len(btc.sampleTimes) && btc.sampleTimes[stale].Before(cutoff) {
+		stale++
+	}
+	btc.blockTimeHistory = btc.blockTimeHistory[stale:]
+	btc.sampleTimes = btc.sampleTimes[stale:]
+	// 모든 샘플이 만료되면 EMA도 오래된 값이므로 초기화
+	if stale > 0 && len(btc.blockTimeHistory) == 0 {
+		btc.ema = 0
+	}
+}
+
+// updateEMA folds a new sample into the EMA, seeding it with the first sample
+func (btc *BlockTimeCalculator) updateEMA(sample time.Duration) {
+	if btc.ema == 0 {
+		btc.ema = sample
+		return
+	}
+	btc.ema = time.Duration(btc.emaAlpha*float64(sample) + (1-btc.emaAlpha)*float64(btc.ema))
+}
+
+// GetEMABlockTime returns the exponential moving average block time (0 = no samples)
+func (btc *BlockTimeCalculator) GetEMABlockTime() time.Duration {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
+	btc.evictStale()
+	return btc.ema
 }
 
 func (btc *BlockTimeCalculator) GetAverageBlockTime() time.Duration {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
+	btc.evictStale()
+	return btc.averageLocked()
+}
+
+// averageLocked returns the simple average of the current samples. Callers hold btc.mu.
+func (btc *BlockTimeCalculator) averageLocked() time.Duration {
 	if len(btc.blockTimeHistory) == 0 {
 		return 0
 	}
@@ -51,6 +133,9 @@ func (btc *BlockTimeCalculator) GetAverageBlockTime() time.Duration {
 }
 
 func (btc *BlockTimeCalculator) GetLatestBlockTime() time.Duration {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
+	btc.evictStale()
 	if len(btc.blockTimeHistory) == 0 {
 		return 0
 	}
@@ -58,6 +143,14 @@ func (btc *BlockTimeCalculator) GetLatestBlockTime() time.Duration {
 }
 
 func (btc *BlockTimeCalculator) GetBlockTimeStats() (avg, min, max time.Duration) {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
+	btc.evictStale()
+	return btc.statsLocked()
+}
+
+// statsLocked returns the average, minimum and maximum of the current samples. Callers hold btc.mu.
+func (btc *BlockTimeCalculator) statsLocked() (avg, min, max time.Duration) {
 	if len(btc.blockTimeHistory) == 0 {
 		return 0, 0, 0
 	}
@@ -95,11 +188,14 @@ func (btc *BlockTimeCalculator) EstimateTimeForBlocks(blockCount int64) time.Dur
 }
 
 func (btc *BlockTimeCalculator) IsBlockTimeStable() bool {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
+	btc.evictStale()
 	if len(btc.blockTimeHistory) < 10 {
 		return false
 	}
 	
-	avg, min, max := btc.GetBlockTimeStats()
+	avg, min, max := btc.statsLocked()
 	if avg == 0 {
 		return false
 	}
@@ -108,19 +204,31 @@ func (btc *BlockTimeCalculator) IsBlockTimeStable() bool {
 	return variance < 0.5
 }
 
+// GetHistorySize returns the number of samples currently used (after count and age eviction)
 func (btc *BlockTimeCalculator) GetHistorySize() int {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
+	btc.evictStale()
 	return len(btc.blockTimeHistory)
 }
 
 func (btc *BlockTimeCalculator) SetInitialBlockTime(blockTime time.Duration) {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
 	btc.blockTimeHistory = []time.Duration{blockTime}
 	btc.lastBlockTime = time.Now()
+	btc.sampleTimes = []time.Time{btc.lastBlockTime}
+	btc.ema = blockTime
 	
 	fmt.Printf("BlockTimeCalculator initialized with external block time: %v\n", blockTime)
 }
 
 func (btc *BlockTimeCalculator) Reset() {
+	btc.mu.Lock()
+	defer btc.mu.Unlock()
 	btc.blockTimeHistory = btc.blockTimeHistory[:0]
+	btc.sampleTimes = btc.sampleTimes[:0]
+	btc.ema = 0
 	btc.lastBlockTime = time.Time{}
 	btc.lastBlockHeight = 0
 }
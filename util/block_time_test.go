@@ -0,0 +1,185 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUpdateBlockTimeUsesHeaderTime(t *testing.T) {
+	calc := NewBlockTimeCalculator(100)
+	base := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	// warm-up: 최신 두 블록의 header 시간
+	calc.UpdateBlockTime(1_000_000, base)
+	calc.UpdateBlockTime(1_000_001, base.Add(1500*time.Millisecond))
+
+	// steady state: 스크레이프 사이에 10블록 생성 (15초)
+	calc.UpdateBlockTime(1_000_011, base.Add(16500*time.Millisecond))
+	// 새 블록 없음
+	calc.UpdateBlockTime(1_000_011, base.Add(16500*time.Millisecond))
+
+	if got := calc.GetHistorySize(); got != 2 {
+		t.Fatalf("history size = %d, want 2", got)
+	}
+	if got := calc.GetAverageBlockTime(); got != 1500*time.Millisecond {
+		t.Errorf("average block time = %v, want 1.5s", got)
+	}
+	if got := calc.GetLatestBlockTime(); got != 1500*time.Millisecond {
+		t.Errorf("latest block time = %v, want 1.5s", got)
+	}
+}
+
+func TestMaxAgeEvictsOldSamples(t *testing.T) {
+	calc := NewBlockTimeCalculator(100)
+	calc.SetMaxAge(30 * time.Minute)
+	now := time.Now()
+
+	// 체인 정지 전 1시간 전 샘플 (블록당 6초)
+	calc.UpdateBlockTime(500, now.Add(-time.Hour-6*time.Second))
+	calc.UpdateBlockTime(501, now.Add(-time.Hour))
+	if got := calc.GetHistorySize(); got != 0 {
+		t.Fatalf("history size with only stale samples = %d, want 0", got)
+	}
+	if got := calc.GetEMABlockTime(); got != 0 {
+		t.Errorf("EMA with only stale samples = %v, want 0", got)
+	}
+
+	// 재개 후: 정지 구간을 포함한 샘플 + 최근 샘플, 1시간 전 샘플은 계속 제외
+	calc.UpdateBlockTime(502, now.Add(-10*time.Second))
+	calc.UpdateBlockTime(507, now)
+	if got := calc.GetHistorySize(); got != 2 {
+		t.Fatalf("history size = %d, want 2", got)
+	}
+	if got := calc.GetLatestBlockTime(); got != 2*time.Second {
+		t.Errorf("latest block time = %v, want 2s", got)
+	}
+}
+
+func TestMaxAgeDisabledKeepsSamples(t *testing.T) {
+	calc := NewBlockTimeCalculator(100)
+	old := time.Now().Add(-24 * time.Hour)
+
+	calc.UpdateBlockTime(1, old)
+	calc.UpdateBlockTime(2, old.Add(time.Second))
+	calc.UpdateBlockTime(3, old.Add(2*time.Second))
+
+	if got := calc.GetHistorySize(); got != 2 {
+		t.Errorf("history size = %d, want 2", got)
+	}
+}
+
+func TestBlockTimeCalculatorConcurrentUse(t *testing.T) {
+	calc := NewBlockTimeCalculator(50)
+	calc.SetMaxAge(time.Minute)
+	start := time.Now().Add(-30 * time.Second)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := int64(1); i <= 500; i++ {
+			calc.UpdateBlockTime(i, start.Add(time.Duration(i)*50*time.Millisecond))
+		}
+	}()
+
+	// getter도 evictStale로 상태를 변경하므로 -race에서 update와 동시에 호출
+	for {
+		select {
+		case <-done:
+			if got := calc.GetHistorySize(); got != 50 {
+				t.Errorf("history size = %d, want 50", got)
+			}
+			return
+		default:
+			calc.GetAverageBlockTime()
+			calc.GetEMABlockTime()
+			calc.GetBlockTimeStats()
+			calc.IsBlockTimeStable()
+		}
+	}
+}
+
+// feedBlockTimes appends one block per interval after the calculator's last block
+func feedBlockTimes(calc *BlockTimeCalculator, height *int64, blockTime *time.Time, interval time.Duration, count int) {
+	for i := 0; i < count; i++ {
+		*height++
+		*blockTime = blockTime.Add(interval)
+		calc.UpdateBlockTime(*height, *blockTime)
+	}
+}
+
+func TestEMAConvergesFasterThanAverage(t *testing.T) {
+	calc := NewBlockTimeCalculator(100)
+	height := int64(1_000_000)
+	blockTime := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	calc.UpdateBlockTime(height, blockTime)
+
+	// 100블록 동안 1초, 이후 블록 시간이 3초로 증가
+	feedBlockTimes(calc, &height, &blockTime, time.Second, 100)
+	if got := calc.GetEMABlockTime(); got != time.Second {
+		t.Fatalf("steady state EMA = %v, want 1s", got)
+	}
+	feedBlockTimes(calc, &height, &blockTime, 3*time.Second, 30)
+
+	// EMA = 3s - 2s*0.9^30 ≈ 2.915s, 단순 평균 = (70*1s + 30*3s) / 100 = 1.6s
+	ema := calc.GetEMABlockTime()
+	average := calc.GetAverageBlockTime()
+	if ema < 2900*time.Millisecond || ema > 2930*time.Millisecond {
+		t.Errorf("EMA after regression = %v, want ~2.915s", ema)
+	}
+	if average != 1600*time.Millisecond {
+		t.Errorf("average after regression = %v, want 1.6s", average)
+	}
+}
+
+func TestEMARecoversFromSingleGap(t *testing.T) {
+	calc := NewBlockTimeCalculator(100)
+	height := int64(1_000_000)
+	blockTime := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	calc.UpdateBlockTime(height, blockTime)
+
+	feedBlockTimes(calc, &height, &blockTime, time.Second, 50)
+	// 30초짜리 블록 하나 (일시적인 지연)
+	feedBlockTimes(calc, &height, &blockTime, 30*time.Second, 1)
+	if got := calc.GetEMABlockTime(); got != 3900*time.Millisecond {
+		t.Errorf("EMA right after the gap = %v, want 3.9s", got)
+	}
+	feedBlockTimes(calc, &height, &blockTime, time.Second, 49)
+
+	// 100개 샘플 중 하나가 30초: 단순 평균은 window를 벗어날 때까지 1.29s, EMA는 1s + 2.9s*0.9^49 ≈ 1.016s
+	if got := calc.GetAverageBlockTime(); got != 1290*time.Millisecond {
+		t.Errorf("average after the gap = %v, want 1.29s", got)
+	}
+	if got := calc.GetEMABlockTime(); got < time.Second || got > 1020*time.Millisecond {
+		t.Errorf("EMA after the gap = %v, want ~1.016s", got)
+	}
+}
+
+func TestSetEMAAlpha(t *testing.T) {
+	calc := NewBlockTimeCalculator(100)
+	// 범위를 벗어난 값은 무시 (DefaultEMAAlpha 유지)
+	calc.SetEMAAlpha(0)
+	calc.SetEMAAlpha(1.5)
+	calc.SetEMAAlpha(-0.2)
+
+	height := int64(1)
+	blockTime := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	calc.UpdateBlockTime(height, blockTime)
+	if got := calc.GetEMABlockTime(); got != 0 {
+		t.Errorf("EMA without samples = %v, want 0", got)
+	}
+	feedBlockTimes(calc, &height, &blockTime, 2*time.Second, 1)
+	if got := calc.GetEMABlockTime(); got != 2*time.Second {
+		t.Errorf("EMA seeded with the first sample = %v, want 2s", got)
+	}
+	feedBlockTimes(calc, &height, &blockTime, 4*time.Second, 1)
+	if got := calc.GetEMABlockTime(); got != 2200*time.Millisecond {
+		t.Errorf("EMA with the default alpha = %v, want 2.2s", got)
+	}
+
+	// alpha 1 = 최신 샘플
+	calc.SetEMAAlpha(1)
+	feedBlockTimes(calc, &height, &blockTime, 6*time.Second, 1)
+	if got := calc.GetEMABlockTime(); got != 6*time.Second {
+		t.Errorf("EMA with alpha 1 = %v, want 6s", got)
+	}
+}
@@ -2,17 +2,28 @@ package util
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// DefaultStakingContract is the 0G staking contract used when no contract address is set
+const DefaultStakingContract = "0xea224dBB52F57752044c0C86aD50930091F561B9"
+
 type EthereumClient struct {
-	RPCURL    string
-	JWTSecret string
-	Client    *http.Client
+	RPCURL          string
+	JWTSecret       string
+	ContractAddress string
+	Client          *http.Client
+
+	ctx context.Context // nil = context.Background (WithContext로 설정)
 }
 
 type JSONRPCRequest struct {
@@ -53,6 +64,27 @@ func NewEthereumClientWithJWT(rpcURL, jwtSecret string) *EthereumClient {
 	}
 }
 
+// WithContract returns a copy of the client whose contract calls target the given address
+func (c *EthereumClient) WithContract(address string) *EthereumClient {
+	clone := *c
+	clone.ContractAddress = address
+	return &clone
+}
+
+// WithContext returns a copy of the client whose calls are canceled with ctx
+func (c *EthereumClient) WithContext(ctx context.Context) *EthereumClient {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
+}
+
+func (c *EthereumClient) contractAddress() string {
+	if c.ContractAddress != "" {
+		return c.ContractAddress
+	}
+	return DefaultStakingContract
+}
+
 func (c *EthereumClient) Call(method string, params interface{}) (json.RawMessage, error) {
 	request := JSONRPCRequest{
 		JSONRPC: "2.0",
@@ -67,7 +99,11 @@ func (c *EthereumClient) Call(method string, params interface{}) (json.RawMessag
 	}
 
 	// JWT 토큰이 설정된 경우 Authorization 헤더 추가
-	req, err := http.NewRequest("POST", c.RPCURL, bytes.NewBuffer(jsonData))
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", c.RPCURL, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -79,6 +115,9 @@ func (c *EthereumClient) Call(method string, params interface{}) (json.RawMessag
 	
 	resp, err := c.Client.Do(req)
 	if err != nil {
+		if isTLSError(err) {
+			return nil, fmt.Errorf("TLS handshake with %s failed (check ethereum.tls cert_file / key_file / ca_file): %w", c.RPCURL, err)
+		}
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
 	defer resp.Body.Close()
@@ -95,6 +134,18 @@ func (c *EthereumClient) Call(method string, params interface{}) (json.RawMessag
 	return response.Result, nil
 }
 
+// isTLSError reports whether a request failed during the TLS handshake (untrusted server certificate,
+// a client certificate rejected or required by the server, or a plain HTTP endpoint)
+func isTLSError(err error) bool {
+	var verifyErr *tls.CertificateVerificationError
+	var alertErr tls.AlertError
+	var recordErr tls.RecordHeaderError
+	var authorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	return errors.As(err, &verifyErr) || errors.As(err, &alertErr) || errors.As(err, &recordErr) ||
+		errors.As(err, &authorityErr) || errors.As(err, &hostnameErr) || strings.Contains(err.Error(), "tls: ")
+}
+
 // GetBlockNumber returns the current block number
 func (c *EthereumClient) GetBlockNumber() (string, error) {
 	result, err := c.Call("eth_blockNumber", []interface{}{})
@@ -112,6 +163,11 @@ func (c *EthereumClient) GetBlockNumber() (string, error) {
 
 // GetBalance returns the balance of an address
 func (c *EthereumClient) GetBalance(address string) (string, error) {
+	address, err := NormalizeHexAddress(address)
+	if err != nil {
+		return "", err
+	}
+
 	params := []interface{}{address, "latest"}
 	result, err := c.Call("eth_getBalance", params)
 	if err != nil {
@@ -154,12 +210,17 @@ func (c *EthereumClient) GetValidatorInfo(validatorAddress string) (map[string]i
 	// keccak256("getValidatorInfo(address)") = 0x8b5a9c0d
 	functionSelector := "0x8b5a9c0d"
 	
+	address, err := NormalizeHexAddress(validatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	// Pad the address to 32 bytes
-	paddedAddress := "000000000000000000000000" + validatorAddress[2:] // Remove 0x and pad
+	paddedAddress := "000000000000000000000000" + StripHexPrefix(address)
 	
 	data := functionSelector + paddedAddress
 	
-	result, err := c.CallContract("0xea224dBB52F57752044c0C86aD50930091F561B9", data)
+	result, err := c.CallContract(c.contractAddress(), data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call getValidatorInfo: %w", err)
 	}
@@ -174,23 +235,21 @@ func (c *EthereumClient) GetValidatorInfo(validatorAddress string) (map[string]i
 
 
 
+// validatorListSignature is the staking contract method that enumerates the registered validators
+const validatorListSignature = "getValidators()"
+
 // GetValidatorsList retrieves the list of all validators from the staking contract
 func (c *EthereumClient) GetValidatorsList() ([]string, error) {
-	// Function signature: validatorCount()
-	// keccak256("validatorCount()") = 0x8b5a9c0d (placeholder - need actual signature)
-	functionSelector := "0x8b5a9c0d"
-	
-	_, err := c.CallContract("0xea224dBB52F57752044c0C86aD50930091F561B9", functionSelector)
+	result, err := c.CallContract(c.contractAddress(), FunctionSelector(validatorListSignature))
 	if err != nil {
-		return nil, fmt.Errorf("failed to call validatorCount: %w", err)
+		return nil, fmt.Errorf("failed to call %s: %w", validatorListSignature, err)
 	}
-	
-	// For now, return a hardcoded list based on what we know
-	// In a real implementation, you would parse the result
-	return []string{
-		"0x30535EF0D596876C5DBFCF825D64134550AB4945",
-		"0x00092f31B30461501CA6311Fc225f8f1ddFbE67e",
-	}, nil
+
+	validators, err := DecodeAddressArray(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s result: %w", validatorListSignature, err)
+	}
+	return validators, nil
 }
 
 // GetTotalValidators returns the total number of registered validators
@@ -199,7 +258,7 @@ func (c *EthereumClient) GetTotalValidators() (int64, error) {
 	// keccak256("totalValidators()") = 0x18160ddd (placeholder)
 	functionSelector := "0x18160ddd"
 	
-	result, err := c.CallContract("0xea224dBB52F57752044c0C86aD50930091F561B9", functionSelector)
+	result, err := c.CallContract(c.contractAddress(), functionSelector)
 	if err != nil {
 		return 0, fmt.Errorf("failed to call totalValidators: %w", err)
 	}
@@ -220,7 +279,7 @@ func (c *EthereumClient) GetActiveValidators() (int64, error) {
 	// keccak256("activeValidators()") = 0x8b5a9c0d (placeholder)
 	functionSelector := "0x8b5a9c0d"
 	
-	result, err := c.CallContract("0xea224dBB52F57752044c0C86aD50930091F561B9", functionSelector)
+	result, err := c.CallContract(c.contractAddress(), functionSelector)
 	if err != nil {
 		return 0, fmt.Errorf("failed to call activeValidators: %w", err)
 	}
@@ -241,7 +300,7 @@ func (c *EthereumClient) GetStakingPool() (string, error) {
 	// keccak256("stakingPool()") = 0x8b5a9c0d (placeholder)
 	functionSelector := "0x8b5a9c0d"
 	
-	result, err := c.CallContract("0xea224dBB52F57752044c0C86aD50930091F561B9", functionSelector)
+	result, err := c.CallContract(c.contractAddress(), functionSelector)
 	if err != nil {
 		return "", fmt.Errorf("failed to call stakingPool: %w", err)
 	}
@@ -255,7 +314,7 @@ func (c *EthereumClient) GetValidatorCount() (uint32, error) {
 	// keccak256("validatorCount()") = 0x8b5a9c0d (placeholder)
 	functionSelector := "0x8b5a9c0d"
 	
-	result, err := c.CallContract("0xea224dBB52F57752044c0C86aD50930091F561B9", functionSelector)
+	result, err := c.CallContract(c.contractAddress(), functionSelector)
 	if err != nil {
 		return 0, fmt.Errorf("failed to call validatorCount: %w", err)
 	}
@@ -276,7 +335,7 @@ func (c *EthereumClient) GetMaxValidatorCount() (uint32, error) {
 	// keccak256("maxValidatorCount()") = 0x8b5a9c0d (placeholder)
 	functionSelector := "0x8b5a9c0d"
 	
-	result, err := c.CallContract("0xea224dBB52F57752044c0C86aD50930091F561B9", functionSelector)
+	result, err := c.CallContract(c.contractAddress(), functionSelector)
 	if err != nil {
 		return 0, fmt.Errorf("failed to call maxValidatorCount: %w", err)
 	}
@@ -291,42 +350,44 @@ func (c *EthereumClient) GetMaxValidatorCount() (uint32, error) {
 	return 0, fmt.Errorf("failed to parse maxValidatorCount result")
 }
 
-// GetValidatorByPubkey returns the validator address for a given public key
+// GetValidatorByPubkey returns the validator address registered for a given public key
+// (the zero address when the pubkey is not registered)
 func (c *EthereumClient) GetValidatorByPubkey(pubkey string) (string, error) {
-	// Function signature: getValidator(bytes)
-	// keccak256("getValidator(bytes)") = 0x8b5a9c0d (placeholder)
-	functionSelector := "0x8b5a9c0d"
-	
-	// Pad the pubkey to 32 bytes
-	paddedPubkey := "0000000000000000000000000000000000000000000000000000000000000020" + pubkey[2:]
-	
-	data := functionSelector + paddedPubkey
-	
-	result, err := c.CallContract("0xea224dBB52F57752044c0C86aD50930091F561B9", data)
+	data, err := encodeBytesCall("getValidator(bytes)", pubkey)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := c.CallContract(c.contractAddress(), data)
 	if err != nil {
 		return "", fmt.Errorf("failed to call getValidator: %w", err)
 	}
-	
-	return result, nil
+
+	return DecodeAddress(result)
 }
 
 // ComputeValidatorAddress computes the validator address for a given public key
 func (c *EthereumClient) ComputeValidatorAddress(pubkey string) (string, error) {
-	// Function signature: computeValidatorAddress(bytes)
-	// keccak256("computeValidatorAddress(bytes)") = 0x8b5a9c0d (placeholder)
-	functionSelector := "0x8b5a9c0d"
-	
-	// Pad the pubkey to 32 bytes
-	paddedPubkey := "0000000000000000000000000000000000000000000000000000000000000020" + pubkey[2:]
-	
-	data := functionSelector + paddedPubkey
-	
-	result, err := c.CallContract("0xea224dBB52F57752044c0C86aD50930091F561B9", data)
+	data, err := encodeBytesCall("computeValidatorAddress(bytes)", pubkey)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := c.CallContract(c.contractAddress(), data)
 	if err != nil {
 		return "", fmt.Errorf("failed to call computeValidatorAddress: %w", err)
 	}
-	
-	return result, nil
+
+	return DecodeAddress(result)
+}
+
+// encodeBytesCall builds the calldata of a function taking a single bytes argument
+func encodeBytesCall(signature, data string) (string, error) {
+	normalized, err := NormalizeHexBytes(data, 0)
+	if err != nil {
+		return "", err
+	}
+	return FunctionSelector(signature) + EncodeBytes(normalized), nil
 }
 
 // GetValidatorByIndex retrieves validator information by index
@@ -337,7 +398,7 @@ func (c *EthereumClient) GetValidatorByIndex(index int) (map[string]interface{},
 	functionSelector := "0x8b5a9c0d"
 	data := functionSelector + indexHex
 	
-	result, err := c.CallContract("0xea224dBB52F57752044c0C86aD50930091F561B9", data)
+	result, err := c.CallContract(c.contractAddress(), data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get validator by index: %w", err)
 	}
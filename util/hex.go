@@ -0,0 +1,52 @@
+package util
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StripHexPrefix removes a leading 0x / 0X
+func StripHexPrefix(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		return s[2:]
+	}
+	return s
+}
+
+// NormalizeHexAddress returns a 20-byte Ethereum address as lowercase 0x-prefixed hex, accepting input with or without 0x
+func NormalizeHexAddress(address string) (string, error) {
+	raw := strings.ToLower(StripHexPrefix(address))
+	if len(raw) != 40 {
+		return "", fmt.Errorf("invalid address %q: expected 20 bytes (40 hex chars), got %d chars", address, len(raw))
+	}
+	if _, err := hex.DecodeString(raw); err != nil {
+		return "", fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	return "0x" + raw, nil
+}
+
+// NormalizeHexBytes returns arbitrary hex data (e.g. a pubkey) as lowercase hex without 0x, validating that it is
+// well-formed, even-length hex of the expected byte length (0 = any length)
+func NormalizeHexBytes(data string, expectedLen int) (string, error) {
+	raw := strings.ToLower(StripHexPrefix(data))
+	decoded, err := hex.DecodeString(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid hex data %q: %w", data, err)
+	}
+	if expectedLen > 0 && len(decoded) != expectedLen {
+		return "", fmt.Errorf("invalid hex data %q: expected %d bytes, got %d", data, expectedLen, len(decoded))
+	}
+	return raw, nil
+}
+
+// ParseHexQuantity parses a minimally encoded JSON-RPC quantity such as "0x5" (odd length allowed)
+func ParseHexQuantity(quantity string) (int64, error) {
+	raw := StripHexPrefix(quantity)
+	if raw == "" {
+		return 0, fmt.Errorf("empty hex quantity %q", quantity)
+	}
+	return strconv.ParseInt(raw, 16, 64)
+}
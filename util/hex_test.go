@@ -0,0 +1,112 @@
+package util
+
+import "testing"
+
+func TestNormalizeHexAddress(t *testing.T) {
+	const want = "0x4b20993bc481177ec7e8f571cecae8a9e22c02db"
+	for _, address := range []string{
+		"0x4B20993Bc481177ec7E8f571ceCaE8A9e22C02db",
+		"0X4B20993Bc481177ec7E8f571ceCaE8A9e22C02db",
+		"4B20993Bc481177ec7E8f571ceCaE8A9e22C02db",
+		"4b20993bc481177ec7e8f571cecae8a9e22c02db",
+		" 0x4b20993bc481177ec7e8f571cecae8a9e22c02db ",
+	} {
+		got, err := NormalizeHexAddress(address)
+		if err != nil {
+			t.Errorf("NormalizeHexAddress(%q): %v", address, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("NormalizeHexAddress(%q) = %q, want %q", address, got, want)
+		}
+	}
+}
+
+func TestNormalizeHexAddressInvalid(t *testing.T) {
+	for _, address := range []string{
+		"",
+		"0x",
+		"0x4b20993bc481177ec7e8f571cecae8a9e22c02",     // 19 bytes
+		"0x4b20993bc481177ec7e8f571cecae8a9e22c02db00", // 21 bytes
+		"0x4b20993bc481177ec7e8f571cecae8a9e22c02zz",   // hex 아님
+		"0g1fvsfjw7ysythang73guuan4g48zzcqkmujnpu6",    // bech32
+	} {
+		if got, err := NormalizeHexAddress(address); err == nil {
+			t.Errorf("NormalizeHexAddress(%q) = %q, want an error", address, got)
+		}
+	}
+}
+
+func TestNormalizeHexBytes(t *testing.T) {
+	const pubkey = "02a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02ee0be551b5dc"
+	tests := []struct {
+		name        string
+		data        string
+		expectedLen int
+	}{
+		{name: "with 0x", data: "0x" + pubkey, expectedLen: 33},
+		{name: "without 0x", data: pubkey, expectedLen: 33},
+		{name: "uppercase", data: "0X02A1633CAFCC01EBFB6D78E39F687A1F0995C62FC95F51EAD10A02EE0BE551B5DC", expectedLen: 33},
+		{name: "any length", data: "0x" + pubkey, expectedLen: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeHexBytes(tt.data, tt.expectedLen)
+			if err != nil {
+				t.Fatalf("NormalizeHexBytes(%q, %d): %v", tt.data, tt.expectedLen, err)
+			}
+			if got != pubkey {
+				t.Errorf("NormalizeHexBytes(%q, %d) = %q, want %q", tt.data, tt.expectedLen, got, pubkey)
+			}
+		})
+	}
+}
+
+func TestNormalizeHexBytesInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		expectedLen int
+	}{
+		{name: "odd length", data: "0x02a", expectedLen: 0},
+		{name: "not hex", data: "0xzz", expectedLen: 0},
+		// uncompressed pubkey (65 bytes)에 compressed 길이 지정
+		{name: "wrong length", data: "0x04" + "a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02ee0be551b5dc" + "a1633cafcc01ebfb6d78e39f687a1f0995c62fc95f51ead10a02ee0be551b5dc", expectedLen: 33},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := NormalizeHexBytes(tt.data, tt.expectedLen); err == nil {
+				t.Errorf("NormalizeHexBytes(%q, %d) = %q, want an error", tt.data, tt.expectedLen, got)
+			}
+		})
+	}
+}
+
+func TestParseHexQuantity(t *testing.T) {
+	tests := []struct {
+		quantity string
+		want     int64
+	}{
+		{quantity: "0x0", want: 0},
+		{quantity: "0x5", want: 5},
+		{quantity: "0x1c9c380", want: 30000000},
+		{quantity: "1c9c380", want: 30000000},
+		{quantity: "0X1C9C380", want: 30000000},
+	}
+	for _, tt := range tests {
+		got, err := ParseHexQuantity(tt.quantity)
+		if err != nil {
+			t.Errorf("ParseHexQuantity(%q): %v", tt.quantity, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseHexQuantity(%q) = %d, want %d", tt.quantity, got, tt.want)
+		}
+	}
+
+	for _, quantity := range []string{"", "0x", "0xzz"} {
+		if _, err := ParseHexQuantity(quantity); err == nil {
+			t.Errorf("ParseHexQuantity(%q) succeeded, want an error", quantity)
+		}
+	}
+}
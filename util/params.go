@@ -0,0 +1,42 @@
+package util
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseDurationParam parses a duration chain param, which is a Go / protobuf JSON duration string ("600s")
+// or an integer number of nanoseconds ("600000000000") depending on the SDK version
+func ParseDurationParam(value string) (time.Duration, error) {
+	if nanos, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Duration(nanos), nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration %q: %w", value, err)
+	}
+	return duration, nil
+}
+
+// ParseDecimalParam parses an sdk.Dec chain param (ratios such as "0.050000000000000000") exactly with big.Rat
+// before converting to float64, so 18-decimal values don't depend on float parsing of the full string
+func ParseDecimalParam(value string) (float64, error) {
+	rat, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok {
+		return 0, fmt.Errorf("failed to parse decimal %q", value)
+	}
+	f, _ := rat.Float64()
+	return f, nil
+}
+
+// ParseIntParam parses an integer chain param such as signed_blocks_window
+func ParseIntParam(value string) (int64, error) {
+	n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse integer %q: %w", value, err)
+	}
+	return n, nil
+}
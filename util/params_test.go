@@ -0,0 +1,82 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDurationParam(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		// protobuf JSON duration (SDK 0.46+)
+		{value: "600s", want: 10 * time.Minute},
+		// 나노초 정수 (amino JSON / 구버전 SDK)
+		{value: "600000000000", want: 10 * time.Minute},
+		{value: "1814400s", want: 21 * 24 * time.Hour},
+		{value: "1814400000000000", want: 21 * 24 * time.Hour},
+		{value: "0.5s", want: 500 * time.Millisecond},
+		{value: "10m", want: 10 * time.Minute},
+		{value: "0", want: 0},
+	}
+	for _, tt := range tests {
+		got, err := ParseDurationParam(tt.value)
+		if err != nil {
+			t.Errorf("ParseDurationParam(%q): %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseDurationParam(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseDurationParamInvalid(t *testing.T) {
+	for _, value := range []string{"", "s", "600 seconds", "99999999999999999999"} {
+		if got, err := ParseDurationParam(value); err == nil {
+			t.Errorf("ParseDurationParam(%q) = %v, want an error", value, got)
+		}
+	}
+}
+
+func TestParseDecimalParam(t *testing.T) {
+	tests := []struct {
+		value string
+		want  float64
+	}{
+		{value: "0.050000000000000000", want: 0.05},
+		{value: "0.000100000000000000", want: 0.0001},
+		{value: "0.000000000000000001", want: 1e-18},
+		{value: "1.000000000000000000", want: 1},
+		{value: " 0.5 ", want: 0.5},
+		{value: "0", want: 0},
+	}
+	for _, tt := range tests {
+		got, err := ParseDecimalParam(tt.value)
+		if err != nil {
+			t.Errorf("ParseDecimalParam(%q): %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseDecimalParam(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+
+	for _, value := range []string{"", "abc", "0.05%"} {
+		if got, err := ParseDecimalParam(value); err == nil {
+			t.Errorf("ParseDecimalParam(%q) = %v, want an error", value, got)
+		}
+	}
+}
+
+func TestParseIntParam(t *testing.T) {
+	if got, err := ParseIntParam(" 10000 "); err != nil || got != 10000 {
+		t.Errorf("ParseIntParam(10000) = %d, %v", got, err)
+	}
+	for _, value := range []string{"", "10000.0", "1e4"} {
+		if got, err := ParseIntParam(value); err == nil {
+			t.Errorf("ParseIntParam(%q) = %d, want an error", value, got)
+		}
+	}
+}
@@ -2,14 +2,19 @@ package util
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 )
 
+// ErrNoPrometheusResults is returned when Prometheus answered the query but has no matching series
+var ErrNoPrometheusResults = errors.New("no results found")
+
 type PrometheusClient struct {
 	serverURL string
 	client    *http.Client
@@ -29,8 +34,9 @@ type PrometheusResponse struct {
 func NewPrometheusClient(serverURL string) *PrometheusClient {
 	return &PrometheusClient{
 		serverURL: serverURL,
+		// backref 조회가 수집을 오래 막지 않도록 짧은 timeout
 		client: &http.Client{
-			Timeout: 10 * time.Second,
+			Timeout: 3 * time.Second,
 		},
 	}
 }
@@ -45,9 +51,9 @@ func (pc *PrometheusClient) GetMetricValue(metricName string, labels map[string]
 		query = fmt.Sprintf("%s{%s}", metricName, strings.Join(labelParts, ","))
 	}
 
-	url := fmt.Sprintf("%s/api/v1/query?query=%s", pc.serverURL, query)
+	queryURL := fmt.Sprintf("%s/api/v1/query?query=%s", pc.serverURL, url.QueryEscape(query))
 	
-	resp, err := pc.client.Get(url)
+	resp, err := pc.client.Get(queryURL)
 	if err != nil {
 		return 0, fmt.Errorf("failed to query Prometheus: %w", err)
 	}
@@ -68,11 +74,18 @@ func (pc *PrometheusClient) GetMetricValue(metricName string, labels map[string]
 	}
 
 	if len(promResp.Data.Result) == 0 {
-		return 0, fmt.Errorf("no results found for metric: %s", metricName)
+		return 0, fmt.Errorf("%w for metric: %s", ErrNoPrometheusResults, metricName)
 	}
 
-	// 첫 번째 결과의 값을 파싱
-	value := promResp.Data.Result[0].Value[1].(string)
+	// 첫 번째 결과의 값을 파싱 ([timestamp, "value"])
+	sample := promResp.Data.Result[0].Value
+	if len(sample) < 2 {
+		return 0, fmt.Errorf("malformed sample for metric: %s", metricName)
+	}
+	value, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("malformed sample value for metric: %s", metricName)
+	}
 	return strconv.ParseFloat(value, 64)
 }
 
@@ -84,8 +97,8 @@ func (pc *PrometheusClient) GetBlockTime() (time.Duration, error) {
 	return time.Duration(value * float64(time.Second)), nil
 }
 
-func (pc *PrometheusClient) GetAverageBlockTime() (time.Duration, error) {
-	value, err := pc.GetMetricValue("cosmos_avg_block_time", nil)
+func (pc *PrometheusClient) GetAverageBlockTime(chainID string) (time.Duration, error) {
+	value, err := pc.GetMetricValue("cosmos_avg_block_time", map[string]string{"chain_id": chainID})
 	if err != nil {
 		return 0, err
 	}